@@ -0,0 +1,379 @@
+package calendly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	eventTypeAvailableTimesPath   = "event_type_available_times"
+	userBusyTimesPath             = "user_busy_times"
+	userAvailabilitySchedulesPath = "user_availability_schedules"
+
+	// Kinds of availability rules
+	AvailabilityRuleWeekday AvailabilityRuleType = "wday"
+	AvailabilityRuleDate    AvailabilityRuleType = "date"
+
+	// Kinds of busy times
+	BusyTimeCalendly BusyTimeType = "calendly"
+	BusyTimeExternal BusyTimeType = "external"
+	BusyTimeReserved BusyTimeType = "reserved"
+
+	availabilityDateLayout = "2006-01-02"
+)
+
+// AvailabilityAPI is the interface of the availability service, implemented by AvailabilityService.
+type AvailabilityAPI interface {
+	EventTypeAvailableTimes(ctx context.Context, eventType string, window TimeRange) ([]*AvailableTime, *Response, error)
+	UserBusyTimes(ctx context.Context, user string, window TimeRange) ([]*BusyTime, *Response, error)
+	ListSchedules(ctx context.Context, user string) ([]*AvailabilitySchedule, *Response, error)
+	GetSchedule(ctx context.Context, uuidOrURI string) (*AvailabilitySchedule, *Response, error)
+}
+
+// AvailabilityService gives access to the open slots of event types and to the busy times
+// and availability schedules of users. API v2 only.
+type AvailabilityService apiService
+
+// Kind of an availability rule
+type AvailabilityRuleType string
+
+// Kind of a busy time
+type BusyTimeType string
+
+// TimeRange is the half-open interval of time [Start, End).
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the range.
+func (r TimeRange) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// Contains reports whether t falls within the range.
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+func (r TimeRange) String() string {
+	return fmt.Sprintf("%v - %v", r.Start.Format(time.RFC3339), r.End.Format(time.RFC3339))
+}
+
+// An open slot of an event type
+type AvailableTime struct {
+	Status            string    `json:"status"`
+	InviteesRemaining int       `json:"invitees_remaining"`
+	StartTime         time.Time `json:"start_time"`
+
+	// URL booking this slot directly
+	SchedulingURL string `json:"scheduling_url"`
+}
+
+// A time a user is not available, either because of a Calendly event or an event of a
+// connected calendar
+type BusyTime struct {
+	Type      BusyTimeType `json:"type"`
+	StartTime time.Time    `json:"start_time"`
+	EndTime   time.Time    `json:"end_time"`
+
+	// Start and end times including the buffers of the event type
+	BufferedStartTime time.Time `json:"buffered_start_time,omitempty"`
+	BufferedEndTime   time.Time `json:"buffered_end_time,omitempty"`
+
+	// Set for the busy times of Calendly events
+	Event *BusyTimeEvent `json:"event,omitempty"`
+}
+
+type BusyTimeEvent struct {
+	URI string `json:"uri"`
+}
+
+// Range returns the time the user is busy for.
+func (b *BusyTime) Range() TimeRange {
+	return TimeRange{Start: b.StartTime, End: b.EndTime}
+}
+
+// AvailabilitySchedule is the set of rules defining when a user can be booked.
+type AvailabilitySchedule struct {
+	URI     string `json:"uri"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+	User    string `json:"user"`
+
+	// Timezone the rules are expressed in, see UserAttributes.Timezone about the timezone database
+	Timezone *time.Location `json:"timezone"`
+
+	Rules []*AvailabilityRule `json:"rules"`
+
+	// IANA name of the timezone as returned by Calendly. It is kept when the name cannot
+	// be resolved, in which case Timezone is nil, and is used to encode a nil Timezone.
+	TimezoneName string `json:"-"`
+}
+
+// availabilityScheduleJSON is the wire format of AvailabilitySchedule, which carries the
+// timezone by name.
+type availabilityScheduleJSON AvailabilitySchedule
+
+// AvailabilityRule lists the intervals a user is available on a weekday, or on a single
+// date overriding the weekday rules.
+type AvailabilityRule struct {
+	Type AvailabilityRuleType `json:"type"`
+
+	// Lowercase weekday of the wday rules, e.g. "monday"
+	Wday string `json:"wday,omitempty"`
+
+	// Date of the date rules, formatted as YYYY-MM-DD
+	Date string `json:"date,omitempty"`
+
+	// An empty list makes the user unavailable for the whole day
+	Intervals []*AvailabilityInterval `json:"intervals"`
+}
+
+// AvailabilityInterval is an interval of a day, with times formatted as "HH:MM".
+type AvailabilityInterval struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// availabilityWindowOpts are the query parameters of the endpoints taking a window of time.
+type availabilityWindowOpts struct {
+	EventType string    `url:"event_type,omitempty"`
+	User      string    `url:"user,omitempty"`
+	StartTime time.Time `url:"start_time"`
+	EndTime   time.Time `url:"end_time"`
+}
+
+type schedulesOpts struct {
+	User string `url:"user"`
+}
+
+type availableTimesResponse struct {
+	Collection []*AvailableTime `json:"collection"`
+}
+
+type busyTimesResponse struct {
+	Collection []*BusyTime `json:"collection"`
+}
+
+type scheduleResponse struct {
+	Resource *AvailabilitySchedule `json:"resource"`
+}
+
+type scheduleListResponse struct {
+	Collection []*AvailabilitySchedule `json:"collection"`
+}
+
+func (s *AvailabilitySchedule) UnmarshalJSON(data []byte) error {
+	aux := &struct {
+		Timezone string `json:"timezone"`
+		*availabilityScheduleJSON
+	}{availabilityScheduleJSON: (*availabilityScheduleJSON)(s)}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	s.Timezone = loadLocation(aux.Timezone)
+	s.TimezoneName = aux.Timezone
+	return nil
+}
+
+func (s AvailabilitySchedule) MarshalJSON() ([]byte, error) {
+	aux := &struct {
+		Timezone string `json:"timezone"`
+		*availabilityScheduleJSON
+	}{Timezone: s.TimezoneName, availabilityScheduleJSON: (*availabilityScheduleJSON)(&s)}
+	if s.Timezone != nil {
+		aux.Timezone = s.Timezone.String()
+	}
+
+	return json.Marshal(aux)
+}
+
+// Ranges returns the concrete ranges of time within the window the schedule makes the user
+// available, in the timezone of the schedule. Date rules override the weekday rules of their day.
+// An error is returned when the timezone of the schedule could not be resolved.
+func (s *AvailabilitySchedule) Ranges(window TimeRange) ([]TimeRange, error) {
+	loc := s.Timezone
+	if loc == nil {
+		return nil, fmt.Errorf("go-calendly: unknown availability schedule timezone %q", s.TimezoneName)
+	}
+
+	weekdays := map[string]*AvailabilityRule{}
+	dates := map[string]*AvailabilityRule{}
+	for _, r := range s.Rules {
+		switch r.Type {
+		case AvailabilityRuleWeekday:
+			weekdays[strings.ToLower(r.Wday)] = r
+		case AvailabilityRuleDate:
+			dates[r.Date] = r
+		}
+	}
+
+	var ranges []TimeRange
+	start := window.Start.In(loc)
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc); day.Before(window.End); day = day.AddDate(0, 0, 1) {
+		rule, ok := dates[day.Format(availabilityDateLayout)]
+		if !ok {
+			rule, ok = weekdays[strings.ToLower(day.Weekday().String())]
+		}
+		if !ok {
+			continue
+		}
+
+		for _, interval := range rule.Intervals {
+			r, err := interval.on(day)
+			if err != nil {
+				return nil, err
+			}
+
+			if r.Start.Before(window.Start) {
+				r.Start = window.Start.In(loc)
+			}
+			if r.End.After(window.End) {
+				r.End = window.End.In(loc)
+			}
+			if r.Start.Before(r.End) {
+				ranges = append(ranges, r)
+			}
+		}
+	}
+
+	return ranges, nil
+}
+
+// on returns the interval as a range of time on the given day.
+func (i *AvailabilityInterval) on(day time.Time) (TimeRange, error) {
+	from, err := parseClock(i.From)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	to, err := parseClock(i.To)
+	if err != nil {
+		return TimeRange{}, err
+	}
+
+	y, m, d := day.Date()
+	return TimeRange{
+		Start: time.Date(y, m, d, from/60, from%60, 0, 0, day.Location()),
+		End:   time.Date(y, m, d, to/60, to%60, 0, 0, day.Location()),
+	}, nil
+}
+
+// parseClock returns the minutes since midnight of an "HH:MM" time, accepting "24:00".
+func parseClock(s string) (int, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil || h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, fmt.Errorf("go-calendly: malformed availability interval time %q", s)
+	}
+
+	return h*60 + m, nil
+}
+
+// EventTypeAvailableTimes returns the open slots of the event type identified by its URI
+// starting within the window. Calendly limits the window to a week.
+func (s *AvailabilityService) EventTypeAvailableTimes(ctx context.Context, eventType string, window TimeRange) ([]*AvailableTime, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	u, err := addUrlOptions(eventTypeAvailableTimesPath, &availabilityWindowOpts{
+		EventType: eventType,
+		StartTime: window.Start.UTC(),
+		EndTime:   window.End.UTC(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	at := &availableTimesResponse{}
+	resp, err := s.client.Do(ctx, req, at)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return at.Collection, resp, nil
+}
+
+// UserBusyTimes returns the times the user identified by its URI is busy within the window.
+// Calendly limits the window to a week.
+func (s *AvailabilityService) UserBusyTimes(ctx context.Context, user string, window TimeRange) ([]*BusyTime, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	u, err := addUrlOptions(userBusyTimesPath, &availabilityWindowOpts{
+		User:      user,
+		StartTime: window.Start.UTC(),
+		EndTime:   window.End.UTC(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bt := &busyTimesResponse{}
+	resp, err := s.client.Do(ctx, req, bt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bt.Collection, resp, nil
+}
+
+// ListSchedules returns the availability schedules of the user identified by its URI.
+func (s *AvailabilityService) ListSchedules(ctx context.Context, user string) ([]*AvailabilitySchedule, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	u, err := addUrlOptions(userAvailabilitySchedulesPath, &schedulesOpts{User: user})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sl := &scheduleListResponse{}
+	resp, err := s.client.Do(ctx, req, sl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sl.Collection, resp, nil
+}
+
+// GetSchedule returns a single availability schedule by its UUID or URI.
+func (s *AvailabilityService) GetSchedule(ctx context.Context, uuidOrURI string) (*AvailabilitySchedule, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(userAvailabilitySchedulesPath, uuidOrURI))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sr := &scheduleResponse{}
+	resp, err := s.client.Do(ctx, req, sr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sr.Resource, resp, nil
+}
@@ -0,0 +1,155 @@
+package calendly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestAvailabilityService_EventTypeAvailableTimes() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+eventTypeAvailableTimesPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		q := r.URL.Query()
+		assert.Equal("https://api.calendly.com/event_types/E1", q.Get("event_type"))
+		assert.Equal("2020-01-01T00:00:00Z", q.Get("start_time"))
+		assert.Equal("2020-01-02T00:00:00Z", q.Get("end_time"))
+		fmt.Fprint(w, `{"collection":[{"status":"available","invitees_remaining":1,`+
+			`"start_time":"2020-01-01T09:00:00Z","scheduling_url":"https://calendly.com/me/intro/2020-01-01T09:00:00Z"}]}`)
+	})
+
+	athens, _ := time.LoadLocation("Europe/Athens")
+	window := TimeRange{
+		Start: time.Date(2020, 1, 1, 2, 0, 0, 0, athens),
+		End:   time.Date(2020, 1, 2, 2, 0, 0, 0, athens),
+	}
+	times, _, err := suite.client.Availability.EventTypeAvailableTimes(context.Background(),
+		"https://api.calendly.com/event_types/E1", window)
+	assert.Nil(err)
+
+	want := []*AvailableTime{{
+		Status:            "available",
+		InviteesRemaining: 1,
+		StartTime:         time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC),
+		SchedulingURL:     "https://calendly.com/me/intro/2020-01-01T09:00:00Z",
+	}}
+	assert.Equal(want, times)
+}
+
+func (suite *CalendlyClientTestSuite) TestAvailabilityService_UserBusyTimes() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+userBusyTimesPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("https://api.calendly.com/users/U1", r.URL.Query().Get("user"))
+		fmt.Fprint(w, `{"collection":[{"type":"calendly","start_time":"2020-01-01T10:00:00Z",`+
+			`"end_time":"2020-01-01T10:30:00Z","event":{"uri":"https://api.calendly.com/scheduled_events/S1"}}]}`)
+	})
+
+	window := TimeRange{Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}
+	busy, _, err := suite.client.Availability.UserBusyTimes(context.Background(), "https://api.calendly.com/users/U1", window)
+	assert.Nil(err)
+
+	assert.Len(busy, 1)
+	assert.Equal(BusyTimeCalendly, busy[0].Type)
+	assert.Equal(30*time.Minute, busy[0].Range().Duration())
+	assert.Equal("https://api.calendly.com/scheduled_events/S1", busy[0].Event.URI)
+}
+
+func (suite *CalendlyClientTestSuite) TestAvailabilityService_Schedules() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	schedule := `{"uri":"https://api.calendly.com/user_availability_schedules/A1","name":"Working hours",` +
+		`"default":true,"timezone":"Europe/Athens","rules":[` +
+		`{"type":"wday","wday":"wednesday","intervals":[{"from":"09:00","to":"12:00"},{"from":"13:00","to":"17:00"}]},` +
+		`{"type":"wday","wday":"thursday","intervals":[{"from":"09:00","to":"17:00"}]},` +
+		`{"type":"date","date":"2020-01-02","intervals":[{"from":"10:00","to":"11:00"}]},` +
+		`{"type":"date","date":"2020-01-08","intervals":[]}]}`
+	suite.mux.HandleFunc("/"+userAvailabilitySchedulesPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("https://api.calendly.com/users/U1", r.URL.Query().Get("user"))
+		fmt.Fprintf(w, `{"collection":[%v]}`, schedule)
+	})
+	suite.mux.HandleFunc("/"+userAvailabilitySchedulesPath+"/A1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"resource":%v}`, schedule)
+	})
+
+	schedules, _, err := suite.client.Availability.ListSchedules(context.Background(), "https://api.calendly.com/users/U1")
+	assert.Nil(err)
+	assert.Len(schedules, 1)
+	assert.True(schedules[0].Default)
+	assert.Equal("Europe/Athens", schedules[0].Timezone.String())
+
+	s, _, err := suite.client.Availability.GetSchedule(context.Background(), "A1")
+	assert.Nil(err)
+	assert.Len(s.Rules, 4)
+
+	athens := s.Timezone
+	ranges, err := s.Ranges(TimeRange{
+		Start: time.Date(2020, 1, 1, 10, 0, 0, 0, athens),
+		End:   time.Date(2020, 1, 9, 0, 0, 0, 0, athens),
+	})
+	assert.Nil(err)
+
+	at := func(day, hour int) time.Time { return time.Date(2020, 1, day, hour, 0, 0, 0, athens) }
+	want := []TimeRange{
+		// wednesday, clipped to the start of the window
+		{at(1, 10), at(1, 12)},
+		{at(1, 13), at(1, 17)},
+		// thursday, overridden by its date rule
+		{at(2, 10), at(2, 11)},
+		// the next wednesday is made unavailable by its date rule
+	}
+	assert.Equal(want, ranges)
+}
+
+func (suite *CalendlyClientTestSuite) TestAvailabilitySchedule_RangesMalformed() {
+	assert := assert.New(suite.T())
+
+	s := &AvailabilitySchedule{Timezone: time.UTC, Rules: []*AvailabilityRule{
+		{Type: AvailabilityRuleWeekday, Wday: "wednesday", Intervals: []*AvailabilityInterval{{From: "9am", To: "24:00"}}},
+	}}
+	_, err := s.Ranges(TimeRange{Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)})
+	assert.NotNil(err)
+
+	s.Rules[0].Intervals[0].From = "18:00"
+	ranges, err := s.Ranges(TimeRange{Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)})
+	assert.Nil(err)
+	assert.Equal([]TimeRange{{time.Date(2020, 1, 1, 18, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}}, ranges)
+}
+
+func (suite *CalendlyClientTestSuite) TestAvailabilitySchedule_RangesUnknownTimezone() {
+	assert := assert.New(suite.T())
+
+	s := &AvailabilitySchedule{}
+	assert.Nil(json.Unmarshal([]byte(`{"timezone":"Mars/Olympus_Mons","rules":[{"type":"wday","wday":"wednesday",`+
+		`"intervals":[{"from":"09:00","to":"17:00"}]}]}`), s))
+	assert.Nil(s.Timezone)
+
+	_, err := s.Ranges(TimeRange{Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)})
+	assert.NotNil(err)
+
+	data, err := json.Marshal(s)
+	assert.Nil(err)
+	assert.Contains(string(data), `"timezone":"Mars/Olympus_Mons"`)
+}
+
+func (suite *CalendlyClientTestSuite) TestAvailabilityService_V1Unsupported() {
+	assert := assert.New(suite.T())
+	window := TimeRange{Start: time.Now(), End: time.Now().Add(time.Hour)}
+
+	_, _, err := suite.client.Availability.EventTypeAvailableTimes(context.Background(), "ET1", window)
+	assert.Equal(errUnsupportedVersion, err)
+
+	_, _, err = suite.client.Availability.UserBusyTimes(context.Background(), "U1", window)
+	assert.Equal(errUnsupportedVersion, err)
+
+	_, _, err = suite.client.Availability.ListSchedules(context.Background(), "U1")
+	assert.Equal(errUnsupportedVersion, err)
+}
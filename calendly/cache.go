@@ -0,0 +1,350 @@
+package calendly
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	headerCacheControl = "Cache-Control"
+	headerETag         = "ETag"
+	headerIfNoneMatch  = "If-None-Match"
+)
+
+// Cache stores the responses of GET requests for a client created with WithCache.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored under the key
+	Get(key string) (*CacheEntry, bool)
+
+	// Set stores the entry under the key, replacing any previous one
+	Set(key string, e *CacheEntry)
+
+	// Delete removes the entry stored under the key
+	Delete(key string)
+}
+
+// CacheEntry is a response stored in a Cache.
+type CacheEntry struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+
+	// Time until which the entry can be used without revalidating it, from the max-age
+	// directive of the response. Zero when it must always be revalidated.
+	Expires time.Time `json:"expires"`
+}
+
+// WithCache is a client option for caching the responses of GET requests, so the ones served
+// again and again such as Users.AboutMe are not downloaded every time. Caching is disabled by
+// default.
+//
+// Responses are stored per URL and token. Requests whose token cannot be determined, such as
+// the ones of an http.Client authorizing them with another RoundTripper than Transport, are
+// never answered from nor stored in the cache. An entry is reused without a request until the
+// max-age of its Cache-Control header elapses, then revalidated with an If-None-Match request
+// when it has an ETag. Responses marked no-store, and the ones that have neither a max-age
+// nor an ETag, are not stored. A successful request of another method evicts the entry of
+// its URL, but not the ones of the lists it belongs to.
+func WithCache(cache Cache) ClientOpt {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// roundTripCached is roundTrip answering GET requests from the cache of the client.
+func (c *Client) roundTripCached(ctx context.Context, req *http.Request) (*Response, error) {
+	key, ok := c.cacheKey(ctx, req)
+	if !ok {
+		return c.roundTrip(ctx, req)
+	}
+
+	if req.Method != http.MethodGet {
+		response, err := c.roundTrip(ctx, req)
+		if err == nil && response.StatusCode < http.StatusMultipleChoices {
+			c.cache.Delete(key)
+		}
+
+		return response, err
+	}
+
+	entry, ok := c.cache.Get(key)
+	if ok && time.Now().Before(entry.Expires) {
+		return &Response{Response: entry.response(req), Cached: true}, nil
+	}
+
+	if ok && entry.Header.Get(headerETag) != "" {
+		req = req.Clone(ctx)
+		req.Header.Set(headerIfNoneMatch, entry.Header.Get(headerETag))
+	}
+
+	response, err := c.roundTrip(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ok && response.StatusCode == http.StatusNotModified:
+		response.Body.Close()
+
+		// The 304 carries the current validators and caching directives of the entry
+		for _, name := range []string{headerCacheControl, headerETag} {
+			if v := response.Header.Get(name); v != "" {
+				entry.Header.Set(name, v)
+			}
+		}
+		entry.Expires = expiresAt(entry.Header)
+		c.cache.Set(key, entry)
+
+		response.Response = entry.response(req)
+		response.Cached = true
+		response.Revalidated = true
+
+	case response.StatusCode == http.StatusOK && cacheable(response.Header):
+		body, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, &NetworkError{Retries: response.Retries, Err: err}
+		}
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		c.cache.Set(key, &CacheEntry{
+			StatusCode: response.StatusCode,
+			Header:     response.Header.Clone(),
+			Body:       body,
+			Expires:    expiresAt(response.Header),
+		})
+	}
+
+	return response, nil
+}
+
+// cacheKey identifies the response of a request by its URL and a digest of the token
+// authorizing it, so clients sharing a cache never see the responses of another account.
+// It reports false when the token is unknown, as every account would then share the key.
+func (c *Client) cacheKey(ctx context.Context, req *http.Request) (string, bool) {
+	credentials := req.Header.Get(BearerHeaderTokenKey) + req.Header.Get(DefaultHeaderTokenKey)
+	if t, ok := c.client.Transport.(*Transport); ok && credentials == "" {
+		src := t.Source
+		if src == nil && t.config != nil {
+			src = t.config
+		}
+		if src != nil {
+			if token, err := src.Token(ctx); err == nil {
+				credentials = token.AccessToken
+			}
+		}
+	}
+
+	if credentials == "" {
+		return "", false
+	}
+
+	sum := sha256.Sum256([]byte(credentials))
+	return hex.EncodeToString(sum[:8]) + " " + req.URL.String(), true
+}
+
+// response rebuilds the http.Response of the entry.
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %v", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cacheControl parses the directives of the Cache-Control header, lower cased.
+func cacheControl(h http.Header) map[string]string {
+	directives := map[string]string{}
+	for _, part := range strings.Split(h.Get(headerCacheControl), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name != "" {
+			directives[strings.ToLower(name)] = strings.Trim(value, `"`)
+		}
+	}
+
+	return directives
+}
+
+// cacheable reports whether a response is worth storing.
+func cacheable(h http.Header) bool {
+	cc := cacheControl(h)
+	if _, ok := cc["no-store"]; ok {
+		return false
+	}
+
+	return h.Get(headerETag) != "" || !expiresAt(h).IsZero()
+}
+
+// expiresAt returns until when a response is fresh, zero when it must be revalidated.
+func expiresAt(h http.Header) time.Time {
+	cc := cacheControl(h)
+	if _, ok := cc["no-cache"]; ok {
+		return time.Time{}
+	}
+
+	secs, err := strconv.Atoi(cc["max-age"])
+	if err != nil || secs <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(time.Duration(secs) * time.Second)
+}
+
+// MemoryCache is an in-memory Cache evicting the least recently used entries.
+type MemoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache holding at most maxEntries entries, or an unbounded
+// number of them when maxEntries is 0.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{maxEntries: maxEntries, lru: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.lru.MoveToFront(el)
+
+	return el.Value.(*memoryCacheItem).entry.clone(), true
+}
+
+func (m *MemoryCache) Set(key string, e *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*memoryCacheItem).entry = e.clone()
+		m.lru.MoveToFront(el)
+		return
+	}
+
+	m.entries[key] = m.lru.PushFront(&memoryCacheItem{key: key, entry: e.clone()})
+	if m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		m.lru.Remove(el)
+		delete(m.entries, key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.lru.Len()
+}
+
+// clone copies the entry so callers of a MemoryCache cannot modify the stored one.
+func (e *CacheEntry) clone() *CacheEntry {
+	c := *e
+	c.Header = e.Header.Clone()
+	c.Body = append([]byte(nil), e.Body...)
+
+	return &c
+}
+
+// DiskCache is a Cache storing every entry as a JSON file in a directory, so it outlives the
+// process. Entries that cannot be read or written are treated as missing.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache storing its entries in dir, which is created when needed.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{dir: dir}
+}
+
+func (d *DiskCache) Get(key string) (*CacheEntry, bool) {
+	data, err := ioutil.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	e := &CacheEntry{}
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, false
+	}
+
+	return e, true
+}
+
+func (d *DiskCache) Set(key string, e *CacheEntry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(d.dir, 0700); err != nil {
+		return
+	}
+
+	// Written aside then renamed, so concurrent readers never see a partial entry
+	f, err := ioutil.TempFile(d.dir, "entry-*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), d.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
+
+// path returns the file of the entry stored under key. Keys are hashed as they hold URLs.
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
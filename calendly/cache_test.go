@@ -0,0 +1,201 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// useCache replaces the client of the suite by an authorized one storing responses in cache.
+func (suite *CalendlyClientTestSuite) useCache(cache Cache) {
+	suite.client = NewClient(NewBearerAuthClient("token"), WithCache(cache))
+	suite.client.SetBaseURL(suite.server.URL + "/")
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_cacheMaxAge() {
+	assert := assert.New(suite.T())
+	suite.useCache(NewMemoryCache(0))
+
+	calls := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerCacheControl, "private, max-age=60")
+		fmt.Fprint(w, `{"email":"pong"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		echo, resp, err := suite.client.Echo(context.Background())
+
+		assert.Nil(err)
+		assert.Equal("pong", echo.Email)
+		assert.Equal(i == 1, resp.Cached)
+		assert.False(resp.Revalidated)
+	}
+	assert.Equal(1, calls)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_cacheRevalidate() {
+	assert := assert.New(suite.T())
+	suite.useCache(NewMemoryCache(0))
+
+	calls := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerETag, `"v1"`)
+		if r.Header.Get(headerIfNoneMatch) == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `{"email":"pong"}`)
+	})
+
+	_, resp, err := suite.client.Echo(context.Background())
+	assert.Nil(err)
+	assert.False(resp.Cached)
+
+	echo, resp, err := suite.client.Echo(context.Background())
+	assert.Nil(err)
+	assert.Equal("pong", echo.Email)
+	assert.True(resp.Cached)
+	assert.True(resp.Revalidated)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal(2, calls)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_cacheNoStore() {
+	assert := assert.New(suite.T())
+	cache := NewMemoryCache(0)
+	suite.useCache(cache)
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerETag, `"v1"`)
+		w.Header().Set(headerCacheControl, "no-store")
+		fmt.Fprint(w, `{"email":"pong"}`)
+	})
+
+	_, _, err := suite.client.Echo(context.Background())
+	assert.Nil(err)
+	assert.Equal(0, cache.Len())
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_cacheInvalidation() {
+	assert := assert.New(suite.T())
+	cache := NewMemoryCache(0)
+	suite.useCache(cache)
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerCacheControl, "max-age=60")
+		fmt.Fprint(w, `{"email":"pong"}`)
+	})
+
+	req, _ := suite.client.Get("echo")
+	_, err := suite.client.Do(context.Background(), req, nil)
+	assert.Nil(err)
+	assert.Equal(1, cache.Len())
+
+	req, _ = suite.client.Delete("echo")
+	_, err = suite.client.Do(context.Background(), req, nil)
+	assert.Nil(err)
+	assert.Equal(0, cache.Len())
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_cacheIdentity() {
+	assert := assert.New(suite.T())
+	cache := NewMemoryCache(0)
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerCacheControl, "max-age=60")
+		fmt.Fprintf(w, `{"email":%q}`, r.Header.Get(BearerHeaderTokenKey))
+	})
+
+	for _, token := range []string{"t1", "t2"} {
+		client := NewClient(NewBearerAuthClient(token), WithCache(cache))
+		client.SetBaseURL(suite.server.URL + "/")
+
+		echo, resp, err := client.Echo(context.Background())
+
+		assert.Nil(err)
+		assert.False(resp.Cached)
+		assert.Equal("Bearer "+token, echo.Email)
+	}
+	assert.Equal(2, cache.Len())
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_cacheUnknownIdentity() {
+	assert := assert.New(suite.T())
+	cache := NewMemoryCache(0)
+
+	calls := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerCacheControl, "max-age=60")
+		fmt.Fprintf(w, `{"email":%q}`, r.Header.Get(BearerHeaderTokenKey))
+	})
+
+	// the token is added by a transport the client cannot look into
+	for _, token := range []string{"t1", "t2"} {
+		client := NewClient(&http.Client{Transport: headerTransport{BearerHeaderTokenKey: "Bearer " + token}}, WithCache(cache))
+		client.SetBaseURL(suite.server.URL + "/")
+
+		echo, resp, err := client.Echo(context.Background())
+
+		assert.Nil(err)
+		assert.False(resp.Cached)
+		assert.Equal("Bearer "+token, echo.Email)
+	}
+	assert.Equal(2, calls)
+	assert.Equal(0, cache.Len())
+}
+
+// headerTransport sets its headers on the requests it sends with http.DefaultTransport.
+type headerTransport map[string]string
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t {
+		req.Header.Set(k, v)
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestMemoryCache_eviction(t *testing.T) {
+	assert := assert.New(t)
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", &CacheEntry{Body: []byte("a")})
+	cache.Set("b", &CacheEntry{Body: []byte("b")})
+	cache.Get("a")
+	cache.Set("c", &CacheEntry{Body: []byte("c")})
+
+	_, ok := cache.Get("b")
+	assert.False(ok)
+	e, ok := cache.Get("a")
+	assert.True(ok)
+	assert.Equal("a", string(e.Body))
+	assert.Equal(2, cache.Len())
+}
+
+func TestDiskCache(t *testing.T) {
+	assert := assert.New(t)
+	cache := NewDiskCache(t.TempDir() + "/cache")
+
+	_, ok := cache.Get("key")
+	assert.False(ok)
+
+	header := http.Header{}
+	header.Set(headerETag, `"v1"`)
+	cache.Set("key", &CacheEntry{StatusCode: http.StatusOK, Header: header, Body: []byte("{}")})
+	e, ok := cache.Get("key")
+
+	assert.True(ok)
+	assert.Equal(`"v1"`, e.Header.Get(headerETag))
+	assert.Equal("{}", string(e.Body))
+
+	cache.Delete("key")
+	_, ok = cache.Get("key")
+	assert.False(ok)
+}
@@ -8,11 +8,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/go-querystring/query"
-	"golang.org/x/net/context/ctxhttp"
 	"io"
 	"io/ioutil"
+	"sync"
 )
 
 const (
@@ -20,7 +21,6 @@ const (
 	defaultBaseURL = "https://calendly.com/api/v1/"
 	userAgent      = "go-calendly-" + libraryVersion
 	mediaType      = "application/json"
-	textType      = "text/html"
 	testRoute      = "echo"
 )
 
@@ -30,26 +30,83 @@ type Client struct {
 
 	common apiService
 
+	// Calendly API version the client speaks
+	version APIVersion
+
+	// Policy for retrying failed requests
+	retryPolicy RetryPolicy
+
+	// Rate limit reported by the most recent response
+	rateMu sync.Mutex
+	rate   Rate
+
+	// Wait for an exhausted rate limit window to reset before sending requests
+	rateLimitWait bool
+
+	// Cache of GET responses, nil when caching is disabled
+	cache Cache
+
+	// Hooks called around every request
+	hooks []Hooks
+
 	// Base URL for API requests.
 	BaseURL *url.URL
 
 	// User agent for client
 	UserAgent string
 
+	// The services are interfaces implemented by the concrete services talking to Calendly,
+	// so code using the client can replace them, e.g. with the mocks of the calendlymock package.
+
 	// Event Types Service
-	EventTypes EventTypesService
+	EventTypes EventTypesAPI
 
 	// Users Service
-	Users UsersService
+	Users UsersAPI
 
 	// Webhooks Service
-	Webhooks WebhooksService
+	Webhooks WebhooksAPI
+
+	// Scheduled Events Service
+	ScheduledEvents ScheduledEventsAPI
+
+	// Invitees Service
+	Invitees InviteesAPI
+
+	// Organizations Service
+	Organizations OrganizationsAPI
+
+	// Availability Service
+	Availability AvailabilityAPI
+
+	// Scheduling Links Service
+	SchedulingLinks SchedulingLinksAPI
 }
 
 // Response is a Calendly response. This wraps the standard http.Response returned
 // from Calendly.
 type Response struct {
 	*http.Response
+
+	// Paging information of API v2 list responses. The tokens are empty when
+	// there is no further page in that direction.
+	Count             int
+	NextPage          string
+	PreviousPage      string
+	NextPageToken     string
+	PreviousPageToken string
+
+	// Number of times the request was retried according to the retry policy of the client
+	Retries int
+
+	// Rate limit of the token, parsed from the response headers. It is empty when the
+	// response was served from the cache without a request.
+	Rate Rate
+
+	// Whether the response was served from the cache of the client, and whether Calendly
+	// confirmed the cached response was still current with a 304 Not Modified
+	Cached      bool
+	Revalidated bool
 }
 
 // An ErrorResponse reports the error caused by an API request
@@ -62,28 +119,67 @@ type ErrorResponse struct {
 
 	// RequestID returned from the API, useful to contact support.
 	RequestID string `json:"request_id"`
+
+	// Short summary of the error. Only returned by API v2.
+	Title string `json:"title,omitempty"`
+
+	// Invalid parameters of the request. Only returned by API v2.
+	Details []*ErrorDetail `json:"details,omitempty"`
 }
 
 type apiService struct {
 	client *Client
 }
 
-// NewClient returns a new Calendly API client.
-func NewClient(httpClient *http.Client) *Client {
+// ClientOpt is an option that can be passed to NewClient.
+type ClientOpt func(*Client)
+
+// NewClient returns a new Calendly API client. Without options the client talks to
+// the v1 API; pass WithAPIVersion(APIV2) to use the v2 API instead.
+func NewClient(httpClient *http.Client, opts ...ClientOpt) *Client {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
 
 	baseURL, _ := url.Parse(defaultBaseURL)
-	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent}
+	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent, version: APIV1}
 	c.common.client = c
-	c.EventTypes = EventTypesService{c}
-	c.Users = UsersService{c}
-	c.Webhooks = WebhooksService{c}
+	c.EventTypes = &EventTypesService{c}
+	c.Users = &UsersService{c}
+	c.Webhooks = &WebhooksService{c}
+	c.ScheduledEvents = &ScheduledEventsService{c}
+	c.Invitees = &InviteesService{c}
+	c.Organizations = &OrganizationsService{c}
+	c.Availability = &AvailabilityService{c}
+	c.SchedulingLinks = &SchedulingLinksService{c}
+
+	for _, opt := range opts {
+		opt(c)
+	}
 
 	return c
 }
 
+// WithAPIVersion is a client option for selecting the Calendly API version.
+// It also resets the base URL to the default one of that version, so SetBaseURL
+// should be called afterwards when a custom endpoint is needed.
+func WithAPIVersion(v APIVersion) ClientOpt {
+	return func(c *Client) {
+		base := defaultBaseURL
+		if v == APIV2 {
+			base = defaultV2BaseURL
+		}
+
+		c.version = v
+		c.BaseURL, _ = url.Parse(base)
+	}
+}
+
+// APIVersion returns the Calendly API version the client speaks.
+func (c *Client) APIVersion() APIVersion {
+	return c.version
+}
+
 // SetUserAgent is a client option for setting the user agent.
 func (c *Client) SetUserAgent(ua string) {
 	c.UserAgent = ua
@@ -135,10 +231,30 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
-// the raw response will be written to v, without attempting to decode it.
+// the raw response will be written to v, without attempting to decode it. Failed requests are retried
+// according to the RetryPolicy set with WithRetryPolicy, and GET requests are answered from the
+// Cache set with WithCache when possible.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	resp, err := ctxhttp.Do(ctx, c.client, req)
+	if len(c.hooks) > 0 {
+		return c.doWithHooks(ctx, req, v)
+	}
+
+	return c.do(ctx, req, v)
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	var response *Response
+	var err error
+	if c.cache != nil {
+		response, err = c.roundTripCached(ctx, req)
+	} else {
+		response, err = c.roundTrip(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
 
+	resp := response.Response
 	defer func() {
 		if rerr := resp.Body.Close(); err == nil {
 			err = rerr
@@ -147,7 +263,6 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		}
 	}()
 
-	response := newResponse(resp)
 	err = CheckResponse(resp)
 	if err != nil {
 		return response, err
@@ -157,12 +272,19 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
 			if err != nil {
-				return nil, err
+				return nil, &NetworkError{Retries: response.Retries, Err: err}
 			}
 		} else {
 			err = json.NewDecoder(resp.Body).Decode(v)
+			if err == io.EOF {
+				err = nil // ignore EOF errors caused by empty response body
+			}
 			if err != nil {
-				return nil, err
+				return nil, &DecodeError{Response: resp, Err: err}
+			}
+
+			if p, ok := v.(paginatedResponse); ok {
+				response.populatePageValues(p.pagination())
 			}
 		}
 	}
@@ -170,6 +292,33 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, err
 }
 
+// roundTrip sends the request once the rate limit allows it and wraps its response.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request) (*Response, error) {
+	if err := c.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, retries, err := c.send(ctx, req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		var authErr *AuthError
+		if errors.As(err, &authErr) {
+			return nil, authErr
+		}
+
+		return nil, &NetworkError{Retries: retries, Err: err}
+	}
+
+	response := newResponse(resp)
+	response.Retries = retries
+	c.updateRate(response.Rate)
+
+	return response, nil
+}
+
 // Convenient shorthand for GET requests
 func (c *Client) Get(urlStr string) (*http.Request, error) {
 	return c.NewRequest(http.MethodGet, urlStr, nil)
@@ -196,6 +345,10 @@ func (c *Client) Delete(urlStr string) (*http.Request, error) {
 // API error responses are expected to have either no response
 // body, or a JSON response body that maps to ErrorResponse.
 // Any other response body will be silently ignored.
+//
+// The error is one of the typed errors AuthError, PermissionError, NotFoundError,
+// ValidationError, RateLimitError and ServerError matching the status code, or
+// a plain *ErrorResponse for any other status.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; c >= 200 && c <= 299 {
 		return nil
@@ -210,7 +363,11 @@ func CheckResponse(r *http.Response) error {
 		}
 	}
 
-	return errorResponse
+	if errorResponse.RequestID == "" {
+		errorResponse.RequestID = r.Header.Get(headerRequestID)
+	}
+
+	return typedError(errorResponse)
 }
 
 func (r *ErrorResponse) Error() string {
@@ -222,7 +379,21 @@ func (r *ErrorResponse) Error() string {
 		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message)
 }
 
+// Echo tests the authentication token of the client. API v2 has no echo endpoint,
+// so the current user is requested instead.
 func (c *Client) Echo(ctx context.Context) (*Echo, *Response, error) {
+	if c.version == APIV2 {
+		me, resp, err := c.Users.AboutMe(ctx)
+		if err != nil {
+			return nil, resp, err
+		}
+		if me == nil || me.Attributes == nil {
+			return nil, resp, ErrNoUser
+		}
+
+		return &Echo{Email: me.Attributes.Email}, resp, nil
+	}
+
 	req, err := c.NewRequest(http.MethodGet, testRoute, nil)
 	if err != nil {
 		return nil, nil, err
@@ -237,6 +408,14 @@ func (c *Client) Echo(ctx context.Context) (*Echo, *Response, error) {
 	return e, resp, nil
 }
 
+// ValidateCredentials checks up front that the credentials of the client are accepted,
+// returning an *AuthError when they are missing, invalid or expired. Missing credentials
+// and failures of the token source are reported without sending nor retrying the request.
+func (c *Client) ValidateCredentials(ctx context.Context) error {
+	_, _, err := c.Echo(ctx)
+	return err
+}
+
 // Test Authentication Token
 // Use this endpoint to test your Authentication Token.
 type Echo struct {
@@ -276,6 +455,6 @@ func addUrlOptions(s string, opt interface{}) (string, error) {
 
 // newResponse creates a new Response for the provided http.Response
 func newResponse(r *http.Response) *Response {
-	response := Response{Response: r}
+	response := Response{Response: r, Rate: parseRate(r)}
 	return &response
 }
@@ -44,6 +44,20 @@ func (suite *CalendlyClientTestSuite) TearDownTest() {
 	suite.server.Close()
 }
 
+// useAPIV2 replaces the suite client with an API v2 client configured to use the test server.
+func (suite *CalendlyClientTestSuite) useAPIV2() {
+	suite.client = NewClient(nil, WithAPIVersion(APIV2))
+	suite.client.SetBaseURL(suite.server.URL + "/")
+}
+
+// handleAboutMeV2 serves the API v2 current user resource.
+func (suite *CalendlyClientTestSuite) handleAboutMeV2() {
+	suite.mux.HandleFunc("/"+aboutMePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/users/U1",`+
+			`"email":"me@example.com","current_organization":"https://api.calendly.com/organizations/O1"}}`)
+	})
+}
+
 func (suite *CalendlyClientTestSuite) TestClient_TestNewRequest() {
 	assert := assert.New(suite.T())
 
@@ -125,6 +139,57 @@ func (suite *CalendlyClientTestSuite) TestClient_SetBaseUrl() {
 	assert.Equal(suite.client.BaseURL, expectedBaseUrl)
 }
 
+func (suite *CalendlyClientTestSuite) TestClient_WithAPIVersion() {
+	assert := assert.New(suite.T())
+
+	c := NewClient(nil)
+	assert.Equal(APIV1, c.APIVersion())
+	assert.Equal(defaultBaseURL, c.BaseURL.String())
+
+	c = NewClient(nil, WithAPIVersion(APIV2))
+	assert.Equal(APIV2, c.APIVersion())
+	assert.Equal(defaultV2BaseURL, c.BaseURL.String())
+}
+
+func (suite *CalendlyClientTestSuite) TestClient_EchoV2() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	suite.handleAboutMeV2()
+
+	echo, _, err := suite.client.Echo(context.Background())
+	assert.Nil(err)
+	assert.Equal(&Echo{Email: "me@example.com"}, echo)
+}
+
+func (suite *CalendlyClientTestSuite) TestClient_EchoV2EmptyResource() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+aboutMePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	echo, _, err := suite.client.Echo(context.Background())
+	assert.Nil(echo)
+	assert.Equal(ErrNoUser, err)
+
+	_, _, err = suite.client.Webhooks.Create(context.Background(), &WebhooksOpts{
+		Url:    "http://webhook",
+		Events: []EventHookType{InviteeCreatedHookType},
+	})
+	assert.Equal(ErrNoUser, err)
+}
+
+func (suite *CalendlyClientTestSuite) TestUUIDFromURI() {
+	assert := assert.New(suite.T())
+
+	assert.Equal("AAAA", UUIDFromURI("https://api.calendly.com/users/AAAA"))
+	assert.Equal("AAAA", UUIDFromURI("AAAA"))
+	assert.Equal("webhook_subscriptions/AAAA", resourcePath(webhookSubscriptionsPath, "AAAA"))
+	assert.Equal("webhook_subscriptions/AAAA",
+		resourcePath(webhookSubscriptionsPath, "https://api.calendly.com/webhook_subscriptions/AAAA"))
+}
+
 func (suite *CalendlyClientTestSuite) TestClient_SetInvalidBaseUrl() {
 	assert := assert.New(suite.T())
 
@@ -142,7 +207,6 @@ func (suite *CalendlyClientTestSuite) TestClient_SetUserAgent() {
 	assert.Equal(suite.client.UserAgent, expectedUserAgent)
 }
 
-
 func (suite *CalendlyClientTestSuite) TestAddUrlOptions() {
 	assert := assert.New(suite.T())
 	type opts struct {
@@ -174,4 +238,4 @@ func (suite *CalendlyClientTestSuite) TestAddUrlOptions() {
 		})
 
 	}
-}
\ No newline at end of file
+}
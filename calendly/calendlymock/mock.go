@@ -0,0 +1,86 @@
+/*
+Package calendlymock provides mocks of the services of the calendly package, to unit test code
+using a calendly.Client without HTTP.
+
+Every mock has a function field per method of its service. Methods whose function is nil fail
+with a *NotMockedError, and the Iter methods walk through the pages returned by the List functions
+unless they are mocked themselves:
+
+	client, mocks := calendlymock.NewClient()
+	mocks.Users.AboutMeFunc = func(ctx context.Context) (*calendly.AboutMe, *calendly.Response, error) {
+		return &calendly.AboutMe{ID: "https://api.calendly.com/users/U1"}, nil, nil
+	}
+
+	err := codeUnderTest(ctx, client)
+*/
+package calendlymock
+
+import (
+	"fmt"
+
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+var (
+	_ calendly.EventTypesAPI      = (*EventTypesService)(nil)
+	_ calendly.UsersAPI           = (*UsersService)(nil)
+	_ calendly.WebhooksAPI        = (*WebhooksService)(nil)
+	_ calendly.ScheduledEventsAPI = (*ScheduledEventsService)(nil)
+	_ calendly.InviteesAPI        = (*InviteesService)(nil)
+	_ calendly.OrganizationsAPI   = (*OrganizationsService)(nil)
+	_ calendly.AvailabilityAPI    = (*AvailabilityService)(nil)
+	_ calendly.SchedulingLinksAPI = (*SchedulingLinksService)(nil)
+)
+
+// NotMockedError occurs when a method of a mock is called without its function being set.
+type NotMockedError struct {
+	// Service and method, e.g. "Users.AboutMe"
+	Method string
+}
+
+func (e *NotMockedError) Error() string {
+	return fmt.Sprintf("go-calendly: %v is not mocked", e.Method)
+}
+
+// Services holds the mocks installed on a client by NewClient.
+type Services struct {
+	EventTypes      *EventTypesService
+	Users           *UsersService
+	Webhooks        *WebhooksService
+	ScheduledEvents *ScheduledEventsService
+	Invitees        *InviteesService
+	Organizations   *OrganizationsService
+	Availability    *AvailabilityService
+	SchedulingLinks *SchedulingLinksService
+}
+
+// NewClient returns an API v2 client whose services are all mocks, and the mocks.
+// No method is mocked initially.
+func NewClient() (*calendly.Client, *Services) {
+	mocks := &Services{
+		EventTypes:      &EventTypesService{},
+		Users:           &UsersService{},
+		Webhooks:        &WebhooksService{},
+		ScheduledEvents: &ScheduledEventsService{},
+		Invitees:        &InviteesService{},
+		Organizations:   &OrganizationsService{},
+		Availability:    &AvailabilityService{},
+		SchedulingLinks: &SchedulingLinksService{},
+	}
+
+	client := calendly.NewClient(nil, calendly.WithAPIVersion(calendly.APIV2))
+	client.EventTypes = mocks.EventTypes
+	client.Users = mocks.Users
+	client.Webhooks = mocks.Webhooks
+	client.ScheduledEvents = mocks.ScheduledEvents
+	client.Invitees = mocks.Invitees
+	client.Organizations = mocks.Organizations
+	client.Availability = mocks.Availability
+	client.SchedulingLinks = mocks.SchedulingLinks
+
+	return client, mocks
+}
+
+func notMocked(method string) error {
+	return &NotMockedError{Method: method}
+}
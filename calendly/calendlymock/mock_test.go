@@ -0,0 +1,98 @@
+package calendlymock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+// countActiveInvitees is code under test depending on a client.
+func countActiveInvitees(ctx context.Context, client *calendly.Client) (int, error) {
+	me, _, err := client.Users.AboutMe(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := calendly.ListAll(ctx, client.ScheduledEvents.Iter(&calendly.ScheduledEventsOpts{User: me.ID}))
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, e := range events {
+		invitees, err := calendly.ListAll(ctx, client.Invitees.Iter(e.URI, &calendly.InviteesOpts{
+			Status: calendly.InviteeStatusActive,
+		}))
+		if err != nil {
+			return 0, err
+		}
+		count += len(invitees)
+	}
+
+	return count, nil
+}
+
+func TestNewClient(t *testing.T) {
+	assert := assert.New(t)
+	client, mocks := NewClient()
+
+	mocks.Users.AboutMeFunc = func(ctx context.Context) (*calendly.AboutMe, *calendly.Response, error) {
+		return &calendly.AboutMe{ID: "https://api.calendly.com/users/U1"}, nil, nil
+	}
+
+	var pages []string
+	mocks.ScheduledEvents.ListFunc = func(ctx context.Context, opt *calendly.ScheduledEventsOpts) ([]*calendly.ScheduledEvent, *calendly.Response, error) {
+		assert.Equal("https://api.calendly.com/users/U1", opt.User)
+		pages = append(pages, opt.PageToken)
+
+		if opt.PageToken == "" {
+			return []*calendly.ScheduledEvent{{URI: "E1"}}, &calendly.Response{NextPageToken: "next"}, nil
+		}
+		return []*calendly.ScheduledEvent{{URI: "E2"}}, &calendly.Response{}, nil
+	}
+
+	mocks.Invitees.ListFunc = func(ctx context.Context, event string, opt *calendly.InviteesOpts) ([]*calendly.Invitee, *calendly.Response, error) {
+		assert.Equal(calendly.InviteeStatusActive, opt.Status)
+		if event == "E1" {
+			return []*calendly.Invitee{{}, {}}, nil, nil
+		}
+		return []*calendly.Invitee{{}}, nil, nil
+	}
+
+	count, err := countActiveInvitees(context.Background(), client)
+
+	assert.Nil(err)
+	assert.Equal(3, count)
+	assert.Equal([]string{"", "next"}, pages)
+}
+
+func TestNotMocked(t *testing.T) {
+	assert := assert.New(t)
+	client, _ := NewClient()
+
+	_, err := countActiveInvitees(context.Background(), client)
+
+	var nerr *NotMockedError
+	assert.True(errors.As(err, &nerr))
+	assert.Equal("Users.AboutMe", nerr.Method)
+	assert.Equal("go-calendly: Users.AboutMe is not mocked", err.Error())
+}
+
+func TestIterFunc(t *testing.T) {
+	assert := assert.New(t)
+	client, mocks := NewClient()
+
+	mocks.Webhooks.IterSubscriptionsFunc = func(opt *calendly.WebhookSubscriptionsOpts) *calendly.Iterator[*calendly.Webhook] {
+		return calendly.NewIterator("", func(ctx context.Context, pageToken string) ([]*calendly.Webhook, *calendly.Response, error) {
+			return []*calendly.Webhook{{URI: "W1"}}, nil, nil
+		})
+	}
+
+	webhooks, err := calendly.ListAll(context.Background(), client.Webhooks.IterSubscriptions(nil))
+
+	assert.Nil(err)
+	assert.Len(webhooks, 1)
+}
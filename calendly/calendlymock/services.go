@@ -0,0 +1,404 @@
+package calendlymock
+
+import (
+	"context"
+
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+// EventTypesService is a mock of calendly.EventTypesAPI.
+type EventTypesService struct {
+	ListFunc func(ctx context.Context, opt *calendly.EventTypesOpts) ([]*calendly.EventType, *calendly.Response, error)
+	IterFunc func(opt *calendly.EventTypesOpts) *calendly.Iterator[*calendly.EventType]
+	GetFunc  func(ctx context.Context, uuidOrURI string) (*calendly.EventType, *calendly.Response, error)
+}
+
+func (m *EventTypesService) List(ctx context.Context, opt *calendly.EventTypesOpts) ([]*calendly.EventType, *calendly.Response, error) {
+	if m.ListFunc == nil {
+		return nil, nil, notMocked("EventTypes.List")
+	}
+	return m.ListFunc(ctx, opt)
+}
+
+func (m *EventTypesService) Iter(opt *calendly.EventTypesOpts) *calendly.Iterator[*calendly.EventType] {
+	if m.IterFunc != nil {
+		return m.IterFunc(opt)
+	}
+
+	o := calendly.EventTypesOpts{}
+	if opt != nil {
+		o = *opt
+	}
+	return calendly.NewIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*calendly.EventType, *calendly.Response, error) {
+		o.PageToken = pageToken
+		return m.List(ctx, &o)
+	})
+}
+
+func (m *EventTypesService) Get(ctx context.Context, uuidOrURI string) (*calendly.EventType, *calendly.Response, error) {
+	if m.GetFunc == nil {
+		return nil, nil, notMocked("EventTypes.Get")
+	}
+	return m.GetFunc(ctx, uuidOrURI)
+}
+
+// UsersService is a mock of calendly.UsersAPI.
+type UsersService struct {
+	AboutMeFunc func(ctx context.Context) (*calendly.AboutMe, *calendly.Response, error)
+	GetFunc     func(ctx context.Context, uuidOrURI string) (*calendly.AboutMe, *calendly.Response, error)
+}
+
+func (m *UsersService) AboutMe(ctx context.Context) (*calendly.AboutMe, *calendly.Response, error) {
+	if m.AboutMeFunc == nil {
+		return nil, nil, notMocked("Users.AboutMe")
+	}
+	return m.AboutMeFunc(ctx)
+}
+
+func (m *UsersService) Get(ctx context.Context, uuidOrURI string) (*calendly.AboutMe, *calendly.Response, error) {
+	if m.GetFunc == nil {
+		return nil, nil, notMocked("Users.Get")
+	}
+	return m.GetFunc(ctx, uuidOrURI)
+}
+
+// WebhooksService is a mock of calendly.WebhooksAPI.
+type WebhooksService struct {
+	CreateFunc            func(ctx context.Context, opt *calendly.WebhooksOpts) (*calendly.Webhook, *calendly.Response, error)
+	ListFunc              func(ctx context.Context) ([]*calendly.Webhook, *calendly.Response, error)
+	IterFunc              func(opt *calendly.ListOptions) *calendly.Iterator[*calendly.Webhook]
+	ListSubscriptionsFunc func(ctx context.Context, opt *calendly.WebhookSubscriptionsOpts) ([]*calendly.Webhook, *calendly.Response, error)
+	IterSubscriptionsFunc func(opt *calendly.WebhookSubscriptionsOpts) *calendly.Iterator[*calendly.Webhook]
+	GetByIDFunc           func(ctx context.Context, id int64) (*calendly.Webhook, *calendly.Response, error)
+	GetByURIFunc          func(ctx context.Context, uri string) (*calendly.Webhook, *calendly.Response, error)
+	DeleteFunc            func(ctx context.Context, id int64) (*calendly.Response, error)
+	DeleteByURIFunc       func(ctx context.Context, uri string) (*calendly.Response, error)
+	PlanFunc              func(ctx context.Context, desired []*calendly.WebhooksOpts, opt *calendly.ReconcileOpts) (*calendly.WebhookPlan, error)
+	ApplyFunc             func(ctx context.Context, plan *calendly.WebhookPlan) error
+	ReconcileFunc         func(ctx context.Context, desired []*calendly.WebhooksOpts, opt *calendly.ReconcileOpts) (*calendly.WebhookPlan, error)
+}
+
+func (m *WebhooksService) Create(ctx context.Context, opt *calendly.WebhooksOpts) (*calendly.Webhook, *calendly.Response, error) {
+	if m.CreateFunc == nil {
+		return nil, nil, notMocked("Webhooks.Create")
+	}
+	return m.CreateFunc(ctx, opt)
+}
+
+func (m *WebhooksService) List(ctx context.Context) ([]*calendly.Webhook, *calendly.Response, error) {
+	if m.ListFunc == nil {
+		return nil, nil, notMocked("Webhooks.List")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Iter returns the single page of List, as the v1 hooks are not paginated.
+func (m *WebhooksService) Iter(opt *calendly.ListOptions) *calendly.Iterator[*calendly.Webhook] {
+	if m.IterFunc != nil {
+		return m.IterFunc(opt)
+	}
+
+	return calendly.NewIterator("", func(ctx context.Context, pageToken string) ([]*calendly.Webhook, *calendly.Response, error) {
+		webhooks, _, err := m.List(ctx)
+		return webhooks, nil, err
+	})
+}
+
+func (m *WebhooksService) ListSubscriptions(ctx context.Context, opt *calendly.WebhookSubscriptionsOpts) ([]*calendly.Webhook, *calendly.Response, error) {
+	if m.ListSubscriptionsFunc == nil {
+		return nil, nil, notMocked("Webhooks.ListSubscriptions")
+	}
+	return m.ListSubscriptionsFunc(ctx, opt)
+}
+
+func (m *WebhooksService) IterSubscriptions(opt *calendly.WebhookSubscriptionsOpts) *calendly.Iterator[*calendly.Webhook] {
+	if m.IterSubscriptionsFunc != nil {
+		return m.IterSubscriptionsFunc(opt)
+	}
+
+	o := calendly.WebhookSubscriptionsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+	return calendly.NewIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*calendly.Webhook, *calendly.Response, error) {
+		o.PageToken = pageToken
+		return m.ListSubscriptions(ctx, &o)
+	})
+}
+
+func (m *WebhooksService) GetByID(ctx context.Context, id int64) (*calendly.Webhook, *calendly.Response, error) {
+	if m.GetByIDFunc == nil {
+		return nil, nil, notMocked("Webhooks.GetByID")
+	}
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *WebhooksService) GetByURI(ctx context.Context, uri string) (*calendly.Webhook, *calendly.Response, error) {
+	if m.GetByURIFunc == nil {
+		return nil, nil, notMocked("Webhooks.GetByURI")
+	}
+	return m.GetByURIFunc(ctx, uri)
+}
+
+func (m *WebhooksService) Delete(ctx context.Context, id int64) (*calendly.Response, error) {
+	if m.DeleteFunc == nil {
+		return nil, notMocked("Webhooks.Delete")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *WebhooksService) DeleteByURI(ctx context.Context, uri string) (*calendly.Response, error) {
+	if m.DeleteByURIFunc == nil {
+		return nil, notMocked("Webhooks.DeleteByURI")
+	}
+	return m.DeleteByURIFunc(ctx, uri)
+}
+
+func (m *WebhooksService) Plan(ctx context.Context, desired []*calendly.WebhooksOpts, opt *calendly.ReconcileOpts) (*calendly.WebhookPlan, error) {
+	if m.PlanFunc == nil {
+		return nil, notMocked("Webhooks.Plan")
+	}
+	return m.PlanFunc(ctx, desired, opt)
+}
+
+func (m *WebhooksService) Apply(ctx context.Context, plan *calendly.WebhookPlan) error {
+	if m.ApplyFunc == nil {
+		return notMocked("Webhooks.Apply")
+	}
+	return m.ApplyFunc(ctx, plan)
+}
+
+func (m *WebhooksService) Reconcile(ctx context.Context, desired []*calendly.WebhooksOpts, opt *calendly.ReconcileOpts) (*calendly.WebhookPlan, error) {
+	if m.ReconcileFunc == nil {
+		return nil, notMocked("Webhooks.Reconcile")
+	}
+	return m.ReconcileFunc(ctx, desired, opt)
+}
+
+// ScheduledEventsService is a mock of calendly.ScheduledEventsAPI.
+type ScheduledEventsService struct {
+	ListFunc func(ctx context.Context, opt *calendly.ScheduledEventsOpts) ([]*calendly.ScheduledEvent, *calendly.Response, error)
+	IterFunc func(opt *calendly.ScheduledEventsOpts) *calendly.Iterator[*calendly.ScheduledEvent]
+	GetFunc  func(ctx context.Context, uuidOrURI string) (*calendly.ScheduledEvent, *calendly.Response, error)
+}
+
+func (m *ScheduledEventsService) List(ctx context.Context, opt *calendly.ScheduledEventsOpts) ([]*calendly.ScheduledEvent, *calendly.Response, error) {
+	if m.ListFunc == nil {
+		return nil, nil, notMocked("ScheduledEvents.List")
+	}
+	return m.ListFunc(ctx, opt)
+}
+
+func (m *ScheduledEventsService) Iter(opt *calendly.ScheduledEventsOpts) *calendly.Iterator[*calendly.ScheduledEvent] {
+	if m.IterFunc != nil {
+		return m.IterFunc(opt)
+	}
+
+	o := calendly.ScheduledEventsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+	return calendly.NewIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*calendly.ScheduledEvent, *calendly.Response, error) {
+		o.PageToken = pageToken
+		return m.List(ctx, &o)
+	})
+}
+
+func (m *ScheduledEventsService) Get(ctx context.Context, uuidOrURI string) (*calendly.ScheduledEvent, *calendly.Response, error) {
+	if m.GetFunc == nil {
+		return nil, nil, notMocked("ScheduledEvents.Get")
+	}
+	return m.GetFunc(ctx, uuidOrURI)
+}
+
+// InviteesService is a mock of calendly.InviteesAPI.
+type InviteesService struct {
+	ListFunc        func(ctx context.Context, event string, opt *calendly.InviteesOpts) ([]*calendly.Invitee, *calendly.Response, error)
+	IterFunc        func(event string, opt *calendly.InviteesOpts) *calendly.Iterator[*calendly.Invitee]
+	GetFunc         func(ctx context.Context, event, invitee string) (*calendly.Invitee, *calendly.Response, error)
+	CancelEventFunc func(ctx context.Context, event, reason string) (*calendly.Cancellation, *calendly.Response, error)
+}
+
+func (m *InviteesService) List(ctx context.Context, event string, opt *calendly.InviteesOpts) ([]*calendly.Invitee, *calendly.Response, error) {
+	if m.ListFunc == nil {
+		return nil, nil, notMocked("Invitees.List")
+	}
+	return m.ListFunc(ctx, event, opt)
+}
+
+func (m *InviteesService) Iter(event string, opt *calendly.InviteesOpts) *calendly.Iterator[*calendly.Invitee] {
+	if m.IterFunc != nil {
+		return m.IterFunc(event, opt)
+	}
+
+	o := calendly.InviteesOpts{}
+	if opt != nil {
+		o = *opt
+	}
+	return calendly.NewIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*calendly.Invitee, *calendly.Response, error) {
+		o.PageToken = pageToken
+		return m.List(ctx, event, &o)
+	})
+}
+
+func (m *InviteesService) Get(ctx context.Context, event, invitee string) (*calendly.Invitee, *calendly.Response, error) {
+	if m.GetFunc == nil {
+		return nil, nil, notMocked("Invitees.Get")
+	}
+	return m.GetFunc(ctx, event, invitee)
+}
+
+func (m *InviteesService) CancelEvent(ctx context.Context, event, reason string) (*calendly.Cancellation, *calendly.Response, error) {
+	if m.CancelEventFunc == nil {
+		return nil, nil, notMocked("Invitees.CancelEvent")
+	}
+	return m.CancelEventFunc(ctx, event, reason)
+}
+
+// OrganizationsService is a mock of calendly.OrganizationsAPI.
+type OrganizationsService struct {
+	ListMembershipsFunc  func(ctx context.Context, opt *calendly.MembershipsOpts) ([]*calendly.OrganizationMembership, *calendly.Response, error)
+	IterMembershipsFunc  func(opt *calendly.MembershipsOpts) *calendly.Iterator[*calendly.OrganizationMembership]
+	GetMembershipFunc    func(ctx context.Context, uuidOrURI string) (*calendly.OrganizationMembership, *calendly.Response, error)
+	RemoveMembershipFunc func(ctx context.Context, uuidOrURI string) (*calendly.Response, error)
+	ListInvitationsFunc  func(ctx context.Context, organization string, opt *calendly.InvitationsOpts) ([]*calendly.OrganizationInvitation, *calendly.Response, error)
+	IterInvitationsFunc  func(organization string, opt *calendly.InvitationsOpts) *calendly.Iterator[*calendly.OrganizationInvitation]
+	GetInvitationFunc    func(ctx context.Context, organization, invitation string) (*calendly.OrganizationInvitation, *calendly.Response, error)
+	InviteFunc           func(ctx context.Context, organization, email string) (*calendly.OrganizationInvitation, *calendly.Response, error)
+	RevokeInvitationFunc func(ctx context.Context, organization, invitation string) (*calendly.Response, error)
+}
+
+func (m *OrganizationsService) ListMemberships(ctx context.Context, opt *calendly.MembershipsOpts) ([]*calendly.OrganizationMembership, *calendly.Response, error) {
+	if m.ListMembershipsFunc == nil {
+		return nil, nil, notMocked("Organizations.ListMemberships")
+	}
+	return m.ListMembershipsFunc(ctx, opt)
+}
+
+func (m *OrganizationsService) IterMemberships(opt *calendly.MembershipsOpts) *calendly.Iterator[*calendly.OrganizationMembership] {
+	if m.IterMembershipsFunc != nil {
+		return m.IterMembershipsFunc(opt)
+	}
+
+	o := calendly.MembershipsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+	return calendly.NewIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*calendly.OrganizationMembership, *calendly.Response, error) {
+		o.PageToken = pageToken
+		return m.ListMemberships(ctx, &o)
+	})
+}
+
+func (m *OrganizationsService) GetMembership(ctx context.Context, uuidOrURI string) (*calendly.OrganizationMembership, *calendly.Response, error) {
+	if m.GetMembershipFunc == nil {
+		return nil, nil, notMocked("Organizations.GetMembership")
+	}
+	return m.GetMembershipFunc(ctx, uuidOrURI)
+}
+
+func (m *OrganizationsService) RemoveMembership(ctx context.Context, uuidOrURI string) (*calendly.Response, error) {
+	if m.RemoveMembershipFunc == nil {
+		return nil, notMocked("Organizations.RemoveMembership")
+	}
+	return m.RemoveMembershipFunc(ctx, uuidOrURI)
+}
+
+func (m *OrganizationsService) ListInvitations(ctx context.Context, organization string, opt *calendly.InvitationsOpts) ([]*calendly.OrganizationInvitation, *calendly.Response, error) {
+	if m.ListInvitationsFunc == nil {
+		return nil, nil, notMocked("Organizations.ListInvitations")
+	}
+	return m.ListInvitationsFunc(ctx, organization, opt)
+}
+
+func (m *OrganizationsService) IterInvitations(organization string, opt *calendly.InvitationsOpts) *calendly.Iterator[*calendly.OrganizationInvitation] {
+	if m.IterInvitationsFunc != nil {
+		return m.IterInvitationsFunc(organization, opt)
+	}
+
+	o := calendly.InvitationsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+	return calendly.NewIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*calendly.OrganizationInvitation, *calendly.Response, error) {
+		o.PageToken = pageToken
+		return m.ListInvitations(ctx, organization, &o)
+	})
+}
+
+func (m *OrganizationsService) GetInvitation(ctx context.Context, organization, invitation string) (*calendly.OrganizationInvitation, *calendly.Response, error) {
+	if m.GetInvitationFunc == nil {
+		return nil, nil, notMocked("Organizations.GetInvitation")
+	}
+	return m.GetInvitationFunc(ctx, organization, invitation)
+}
+
+func (m *OrganizationsService) Invite(ctx context.Context, organization, email string) (*calendly.OrganizationInvitation, *calendly.Response, error) {
+	if m.InviteFunc == nil {
+		return nil, nil, notMocked("Organizations.Invite")
+	}
+	return m.InviteFunc(ctx, organization, email)
+}
+
+func (m *OrganizationsService) RevokeInvitation(ctx context.Context, organization, invitation string) (*calendly.Response, error) {
+	if m.RevokeInvitationFunc == nil {
+		return nil, notMocked("Organizations.RevokeInvitation")
+	}
+	return m.RevokeInvitationFunc(ctx, organization, invitation)
+}
+
+// AvailabilityService is a mock of calendly.AvailabilityAPI.
+type AvailabilityService struct {
+	EventTypeAvailableTimesFunc func(ctx context.Context, eventType string, window calendly.TimeRange) ([]*calendly.AvailableTime, *calendly.Response, error)
+	UserBusyTimesFunc           func(ctx context.Context, user string, window calendly.TimeRange) ([]*calendly.BusyTime, *calendly.Response, error)
+	ListSchedulesFunc           func(ctx context.Context, user string) ([]*calendly.AvailabilitySchedule, *calendly.Response, error)
+	GetScheduleFunc             func(ctx context.Context, uuidOrURI string) (*calendly.AvailabilitySchedule, *calendly.Response, error)
+}
+
+func (m *AvailabilityService) EventTypeAvailableTimes(ctx context.Context, eventType string, window calendly.TimeRange) ([]*calendly.AvailableTime, *calendly.Response, error) {
+	if m.EventTypeAvailableTimesFunc == nil {
+		return nil, nil, notMocked("Availability.EventTypeAvailableTimes")
+	}
+	return m.EventTypeAvailableTimesFunc(ctx, eventType, window)
+}
+
+func (m *AvailabilityService) UserBusyTimes(ctx context.Context, user string, window calendly.TimeRange) ([]*calendly.BusyTime, *calendly.Response, error) {
+	if m.UserBusyTimesFunc == nil {
+		return nil, nil, notMocked("Availability.UserBusyTimes")
+	}
+	return m.UserBusyTimesFunc(ctx, user, window)
+}
+
+func (m *AvailabilityService) ListSchedules(ctx context.Context, user string) ([]*calendly.AvailabilitySchedule, *calendly.Response, error) {
+	if m.ListSchedulesFunc == nil {
+		return nil, nil, notMocked("Availability.ListSchedules")
+	}
+	return m.ListSchedulesFunc(ctx, user)
+}
+
+func (m *AvailabilityService) GetSchedule(ctx context.Context, uuidOrURI string) (*calendly.AvailabilitySchedule, *calendly.Response, error) {
+	if m.GetScheduleFunc == nil {
+		return nil, nil, notMocked("Availability.GetSchedule")
+	}
+	return m.GetScheduleFunc(ctx, uuidOrURI)
+}
+
+// SchedulingLinksService is a mock of calendly.SchedulingLinksAPI.
+type SchedulingLinksService struct {
+	CreateFunc             func(ctx context.Context, owner string, maxEventCount int) (*calendly.SchedulingLink, *calendly.Response, error)
+	CreateForEventTypeFunc func(ctx context.Context, et *calendly.EventType) (*calendly.SchedulingLink, *calendly.Response, error)
+}
+
+func (m *SchedulingLinksService) Create(ctx context.Context, owner string, maxEventCount int) (*calendly.SchedulingLink, *calendly.Response, error) {
+	if m.CreateFunc == nil {
+		return nil, nil, notMocked("SchedulingLinks.Create")
+	}
+	return m.CreateFunc(ctx, owner, maxEventCount)
+}
+
+func (m *SchedulingLinksService) CreateForEventType(ctx context.Context, et *calendly.EventType) (*calendly.SchedulingLink, *calendly.Response, error) {
+	if m.CreateForEventTypeFunc == nil {
+		return nil, nil, notMocked("SchedulingLinks.CreateForEventType")
+	}
+	return m.CreateForEventTypeFunc(ctx, et)
+}
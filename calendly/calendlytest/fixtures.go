@@ -0,0 +1,109 @@
+package calendlytest
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+// Fixtures are the resources a Server is seeded with, in their API v2 representation.
+// They can be decoded from JSON files with LoadFixtures.
+type Fixtures struct {
+	// The first user is the owner of the token, returned by users/me
+	Users []*User `json:"users"`
+
+	EventTypes      []*EventType               `json:"event_types"`
+	ScheduledEvents []*calendly.ScheduledEvent `json:"scheduled_events"`
+	Invitees        []*calendly.Invitee        `json:"invitees"`
+	Webhooks        []*WebhookSubscription     `json:"webhook_subscriptions"`
+}
+
+// User is the API v2 user resource.
+type User struct {
+	URI                 string    `json:"uri"`
+	Name                string    `json:"name"`
+	Slug                string    `json:"slug"`
+	Email               string    `json:"email"`
+	SchedulingURL       string    `json:"scheduling_url"`
+	Timezone            string    `json:"timezone"`
+	AvatarURL           string    `json:"avatar_url,omitempty"`
+	Locale              string    `json:"locale,omitempty"`
+	TimeNotation        string    `json:"time_notation,omitempty"`
+	CurrentOrganization string    `json:"current_organization"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// EventType is the API v2 event type resource.
+type EventType struct {
+	URI              string                     `json:"uri"`
+	Name             string                     `json:"name"`
+	Active           bool                       `json:"active"`
+	Slug             string                     `json:"slug"`
+	SchedulingURL    string                     `json:"scheduling_url"`
+	Duration         int                        `json:"duration"`
+	Kind             calendly.EventTypeKind     `json:"kind"`
+	PoolingType      calendly.PoolingType       `json:"pooling_type,omitempty"`
+	Color            string                     `json:"color"`
+	DescriptionPlain string                     `json:"description_plain,omitempty"`
+	Secret           bool                       `json:"secret"`
+	CustomQuestions  []*calendly.CustomQuestion `json:"custom_questions,omitempty"`
+	Profile          *calendly.EventTypeProfile `json:"profile,omitempty"`
+	CreatedAt        time.Time                  `json:"created_at"`
+	UpdatedAt        time.Time                  `json:"updated_at"`
+}
+
+// WebhookSubscription is the API v2 webhook subscription resource.
+type WebhookSubscription struct {
+	URI          string                   `json:"uri"`
+	CallbackURL  string                   `json:"callback_url"`
+	State        calendly.WebhookState    `json:"state"`
+	Events       []calendly.EventHookType `json:"events"`
+	Scope        calendly.WebhookScope    `json:"scope"`
+	Organization string                   `json:"organization"`
+	User         string                   `json:"user,omitempty"`
+	Creator      string                   `json:"creator,omitempty"`
+	CreatedAt    time.Time                `json:"created_at"`
+	UpdatedAt    time.Time                `json:"updated_at"`
+
+	// Key signing the deliveries, never returned by the API
+	SigningKey string `json:"-"`
+}
+
+// LoadFixtures decodes a JSON fixtures file.
+func LoadFixtures(path string) (*Fixtures, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return DecodeFixtures(f)
+}
+
+// DecodeFixtures decodes JSON fixtures from r.
+func DecodeFixtures(r io.Reader) (*Fixtures, error) {
+	fixtures := &Fixtures{}
+	if err := json.NewDecoder(r).Decode(fixtures); err != nil {
+		return nil, err
+	}
+
+	return fixtures, nil
+}
+
+// clone returns a deep copy of the fixtures, so the server state is not shared with its callers.
+func (f *Fixtures) clone() *Fixtures {
+	b, _ := json.Marshal(f)
+	c := &Fixtures{}
+	json.Unmarshal(b, c)
+
+	// Signing keys are not part of the JSON representation
+	for i, w := range f.Webhooks {
+		c.Webhooks[i].SigningKey = w.SigningKey
+	}
+
+	return c
+}
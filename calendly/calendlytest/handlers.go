@@ -0,0 +1,396 @@
+package calendlytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+var webhookEvents = map[calendly.EventHookType]bool{
+	calendly.InviteeCreatedHookType:               true,
+	calendly.InviteeCanceledHookType:              true,
+	calendly.InviteeNoShowCreatedHookType:         true,
+	calendly.InviteeNoShowDeletedHookType:         true,
+	calendly.RoutingFormSubmissionCreatedHookType: true,
+}
+
+func (s *Server) getUser(w http.ResponseWriter, uri string) {
+	_, u := find(s.state.Users, calendly.UUIDFromURI(uri))
+	if u == nil {
+		notFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, u)
+}
+
+// organizationOf returns the organization of the user with the given URI.
+func (s *Server) organizationOf(user string) string {
+	_, u := find(s.state.Users, calendly.UUIDFromURI(user))
+	if u == nil {
+		return ""
+	}
+
+	return u.CurrentOrganization
+}
+
+func (s *Server) listEventTypes(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	user, organization := q.Get("user"), q.Get("organization")
+	if user == "" && organization == "" {
+		invalidArgument(w, "user", "user or organization is required")
+		return
+	}
+
+	var active *bool
+	if v := q.Get("active"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			invalidArgument(w, "active", "must be true or false")
+			return
+		}
+		active = &b
+	}
+
+	desc, ok := sortDirection(w, r, "name")
+	if !ok {
+		return
+	}
+
+	eventTypes := []*EventType{}
+	for _, et := range s.state.EventTypes {
+		owner := ""
+		if et.Profile != nil {
+			owner = et.Profile.Owner
+		}
+
+		switch {
+		case user != "" && owner != user:
+		case organization != "" && s.organizationOf(owner) != organization:
+		case active != nil && et.Active != *active:
+		default:
+			eventTypes = append(eventTypes, et)
+		}
+	}
+
+	sort.SliceStable(eventTypes, func(i, j int) bool {
+		if desc {
+			return eventTypes[i].Name > eventTypes[j].Name
+		}
+		return eventTypes[i].Name < eventTypes[j].Name
+	})
+
+	paginate(w, r, eventTypes)
+}
+
+func (s *Server) getEventType(w http.ResponseWriter, uuid string) {
+	_, et := find(s.state.EventTypes, uuid)
+	if et == nil {
+		notFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, et)
+}
+
+func (s *Server) listScheduledEvents(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	user, organization := q.Get("user"), q.Get("organization")
+	if user == "" && organization == "" {
+		invalidArgument(w, "user", "user or organization is required")
+		return
+	}
+
+	var minStart, maxStart time.Time
+	for param, t := range map[string]*time.Time{"min_start_time": &minStart, "max_start_time": &maxStart} {
+		if v := q.Get(param); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				invalidArgument(w, param, "must be an ISO 8601 time")
+				return
+			}
+			*t = parsed
+		}
+	}
+
+	desc, ok := sortDirection(w, r, "start_time")
+	if !ok {
+		return
+	}
+
+	status := calendly.EventStatus(q.Get("status"))
+	inviteeEmail := q.Get("invitee_email")
+
+	events := []*calendly.ScheduledEvent{}
+	for _, e := range s.state.ScheduledEvents {
+		switch {
+		case user != "" && !s.hasMember(e, func(m string) bool { return m == user }):
+		case organization != "" && !s.hasMember(e, func(m string) bool { return s.organizationOf(m) == organization }):
+		case status != "" && e.Status != status:
+		case !minStart.IsZero() && e.StartTime.Before(minStart):
+		case !maxStart.IsZero() && !e.StartTime.Before(maxStart):
+		case inviteeEmail != "" && !s.hasInvitee(e, inviteeEmail):
+		default:
+			events = append(events, e)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if desc {
+			return events[i].StartTime.After(events[j].StartTime)
+		}
+		return events[i].StartTime.Before(events[j].StartTime)
+	})
+
+	paginate(w, r, events)
+}
+
+func (s *Server) hasMember(e *calendly.ScheduledEvent, match func(user string) bool) bool {
+	for _, m := range e.Memberships {
+		if match(m.User) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) hasInvitee(e *calendly.ScheduledEvent, email string) bool {
+	for _, i := range s.state.Invitees {
+		if i.Event == e.URI && strings.EqualFold(i.Email, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) getScheduledEvent(w http.ResponseWriter, uuid string) {
+	_, e := find(s.state.ScheduledEvents, uuid)
+	if e == nil {
+		notFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, e)
+}
+
+// cancelScheduledEvent cancels a scheduled event and its invitees on behalf of the current user.
+func (s *Server) cancelScheduledEvent(w http.ResponseWriter, r *http.Request, uuid string) {
+	_, e := find(s.state.ScheduledEvents, uuid)
+	if e == nil {
+		notFound(w)
+		return
+	}
+
+	body := struct {
+		Reason string `json:"reason"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		invalidArgument(w, "reason", "the request body is not valid JSON")
+		return
+	}
+
+	if e.Status == calendly.EventStatusCanceled {
+		writeError(w, http.StatusForbidden, "Permission Denied", "Event is already canceled")
+		return
+	}
+
+	now := s.now().UTC()
+	c := &calendly.Cancellation{
+		CanceledBy:   s.me().Name,
+		Reason:       body.Reason,
+		CancelerType: "host",
+		CreatedAt:    now,
+	}
+
+	e.Status = calendly.EventStatusCanceled
+	e.Cancellation = c
+	e.UpdatedAt = now
+	for _, i := range s.state.Invitees {
+		if i.Event == e.URI && i.Status == calendly.InviteeStatusActive {
+			i.Status = calendly.InviteeStatusCanceled
+			i.Cancellation = c
+			i.UpdatedAt = now
+		}
+	}
+
+	writeResource(w, http.StatusCreated, c)
+}
+
+func (s *Server) listInvitees(w http.ResponseWriter, r *http.Request, event string) {
+	_, e := find(s.state.ScheduledEvents, event)
+	if e == nil {
+		notFound(w)
+		return
+	}
+
+	desc, ok := sortDirection(w, r, "created_at")
+	if !ok {
+		return
+	}
+
+	q := r.URL.Query()
+	status, email := calendly.InviteeStatus(q.Get("status")), q.Get("email")
+
+	invitees := []*calendly.Invitee{}
+	for _, i := range s.state.Invitees {
+		switch {
+		case i.Event != e.URI:
+		case status != "" && i.Status != status:
+		case email != "" && !strings.EqualFold(i.Email, email):
+		default:
+			invitees = append(invitees, i)
+		}
+	}
+
+	sort.SliceStable(invitees, func(i, j int) bool {
+		if desc {
+			return invitees[i].CreatedAt.After(invitees[j].CreatedAt)
+		}
+		return invitees[i].CreatedAt.Before(invitees[j].CreatedAt)
+	})
+
+	paginate(w, r, invitees)
+}
+
+func (s *Server) getInvitee(w http.ResponseWriter, event, uuid string) {
+	_, e := find(s.state.ScheduledEvents, event)
+	_, i := find(s.state.Invitees, uuid)
+	if e == nil || i == nil || i.Event != e.URI {
+		notFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, i)
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	scope := calendly.WebhookScope(q.Get("scope"))
+	organization, user := q.Get("organization"), q.Get("user")
+
+	switch {
+	case organization == "":
+		invalidArgument(w, "organization", "is required")
+		return
+	case scope != calendly.WebhookScopeUser && scope != calendly.WebhookScopeOrganization:
+		invalidArgument(w, "scope", "must be user or organization")
+		return
+	case scope == calendly.WebhookScopeUser && user == "":
+		invalidArgument(w, "user", "is required for the user scope")
+		return
+	}
+
+	webhooks := []*WebhookSubscription{}
+	for _, wh := range s.state.Webhooks {
+		switch {
+		case wh.Scope != scope:
+		case wh.Organization != organization:
+		case scope == calendly.WebhookScopeUser && wh.User != user:
+		default:
+			webhooks = append(webhooks, wh)
+		}
+	}
+
+	paginate(w, r, webhooks)
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	body := struct {
+		URL          string                   `json:"url"`
+		Events       []calendly.EventHookType `json:"events"`
+		Organization string                   `json:"organization"`
+		User         string                   `json:"user"`
+		Scope        calendly.WebhookScope    `json:"scope"`
+		SigningKey   string                   `json:"signing_key"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		invalidArgument(w, "url", "the request body is not valid JSON")
+		return
+	}
+
+	var invalid []string
+	if u, err := url.Parse(body.URL); err != nil || !u.IsAbs() {
+		invalid = append(invalid, "url", "must be an absolute URL")
+	}
+	if len(body.Events) == 0 {
+		invalid = append(invalid, "events", "is required")
+	}
+	for _, ev := range body.Events {
+		if !webhookEvents[ev] {
+			invalid = append(invalid, "events", "unknown event "+string(ev))
+		}
+	}
+	if body.Organization == "" {
+		invalid = append(invalid, "organization", "is required")
+	}
+	switch body.Scope {
+	case calendly.WebhookScopeOrganization:
+	case calendly.WebhookScopeUser:
+		if body.User == "" {
+			invalid = append(invalid, "user", "is required for the user scope")
+		}
+	default:
+		invalid = append(invalid, "scope", "must be user or organization")
+	}
+	if len(invalid) > 0 {
+		invalidArgument(w, invalid...)
+		return
+	}
+
+	for _, wh := range s.state.Webhooks {
+		if wh.CallbackURL == body.URL && wh.Scope == body.Scope && wh.Organization == body.Organization &&
+			wh.User == body.User {
+			writeError(w, http.StatusConflict, "Already Exists", "Hook with this url already exists")
+			return
+		}
+	}
+
+	now := s.now().UTC()
+	wh := &WebhookSubscription{
+		URI:          s.newURI("webhook_subscriptions"),
+		CallbackURL:  body.URL,
+		State:        calendly.WebhookStateActive,
+		Events:       body.Events,
+		Scope:        body.Scope,
+		Organization: body.Organization,
+		Creator:      s.me().URI,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		SigningKey:   body.SigningKey,
+	}
+	if body.Scope == calendly.WebhookScopeUser {
+		wh.User = body.User
+	}
+	s.state.Webhooks = append(s.state.Webhooks, wh)
+
+	writeResource(w, http.StatusCreated, wh)
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, uuid string) {
+	_, wh := find(s.state.Webhooks, uuid)
+	if wh == nil {
+		notFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, wh)
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, uuid string) {
+	i, wh := find(s.state.Webhooks, uuid)
+	if wh == nil {
+		notFound(w)
+		return
+	}
+
+	s.state.Webhooks = append(s.state.Webhooks[:i], s.state.Webhooks[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,343 @@
+package calendlytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Modes of a Recorder.
+const (
+	// Replay the cassette, failing requests it has no interaction for
+	ModeReplay Mode = iota
+
+	// Send every request and record the interactions, replacing the cassette on Close
+	ModeRecord
+
+	// Replay the cassette when it exists, record it otherwise
+	ModeReplayOrRecord
+)
+
+// Matching modes of a Recorder.
+const (
+	// Replay the interactions once each in the recorded order. A request matches when its
+	// method, URL and body are the recorded ones.
+	MatchStrict Matching = iota
+
+	// Replay the first unused interaction with the method and path of the request, or the
+	// last used one when all were replayed. Query strings and bodies are ignored.
+	MatchLenient
+)
+
+// Replacements of the redacted values.
+const (
+	RedactedHeader = "REDACTED"
+	RedactedField  = "REDACTED"
+	RedactedEmail  = "redacted@example.com"
+)
+
+// Mode selects whether a Recorder records or replays.
+type Mode int
+
+// Matching selects how a Recorder finds the interaction replayed for a request.
+type Matching int
+
+// ErrUnrecordedRequest is matched by the UnrecordedRequestError of requests missing from a cassette.
+var ErrUnrecordedRequest = errors.New("go-calendly: request was not recorded")
+
+// UnrecordedRequestError occurs when a replaying Recorder has no interaction for a request.
+type UnrecordedRequestError struct {
+	Method string
+	URL    string
+
+	// Path of the cassette
+	Cassette string
+}
+
+func (e *UnrecordedRequestError) Is(target error) bool { return target == ErrUnrecordedRequest }
+
+func (e *UnrecordedRequestError) Error() string {
+	return fmt.Sprintf("go-calendly: no interaction of cassette %v matches %v %v, record it again with ModeRecord",
+		e.Cassette, e.Method, e.URL)
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._+-]+(@|%40)[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+
+	// Headers holding credentials
+	redactedHeaders = []string{"Authorization", "X-Token"}
+
+	// JSON and form fields holding credentials: webhook signing keys, OAuth client secrets,
+	// authorization codes and tokens
+	secretFields     = `signing_key|client_secret|code|access_token|refresh_token|token`
+	jsonFieldPattern = regexp.MustCompile(`("(?:` + secretFields + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	formFieldPattern = regexp.MustCompile(`(^|&)((?:` + secretFields + `)=)[^&]*`)
+)
+
+// Cassette holds the interactions recorded by a Recorder.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request of an Interaction, with its credentials and emails redacted.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response of an Interaction, with its credentials and emails redacted.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording the requests of a client to a cassette file and
+// replaying them, so tests can hit Calendly once and then run offline. Credential headers,
+// the signing keys, client secrets, authorization codes and tokens of JSON and form bodies,
+// and email addresses are redacted before they are written. The Recorder must be the base of the
+// transport authorizing the requests so it sees their headers:
+//
+//	rec, err := calendlytest.NewRecorder("testdata/events.json", calendlytest.ModeReplayOrRecord)
+//	defer rec.Close()
+//
+//	client := calendly.NewClient(&http.Client{Transport: &calendly.Transport{
+//		Base:   rec,
+//		Source: calendly.StaticTokenSource(&calendly.Token{AccessToken: os.Getenv("CALENDLY_TOKEN")}),
+//	}}, calendly.WithAPIVersion(calendly.APIV2))
+type Recorder struct {
+	// Transport sending the requests while recording, http.DefaultTransport when nil
+	Transport http.RoundTripper
+
+	// How requests are matched while replaying, MatchStrict by default
+	Matching Matching
+
+	path      string
+	recording bool
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder of the cassette file at path. In replay mode the cassette is
+// loaded and an error returned when it does not exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, cassette: &Cassette{}}
+
+	if mode == ModeReplayOrRecord {
+		mode = ModeReplay
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			mode = ModeRecord
+		}
+	}
+
+	if mode == ModeRecord {
+		r.recording = true
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, r.cassette); err != nil {
+		return nil, fmt.Errorf("go-calendly: cannot decode cassette %v: %v", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+
+	return r, nil
+}
+
+// Recording reports whether the Recorder records the requests instead of replaying them.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// Close writes the recorded cassette to its file. It does nothing while replaying.
+func (r *Recorder) Close() error {
+	if !r.recording {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, data, 0644)
+}
+
+// RoundTrip records or replays the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		// The body of the request was consumed, a copy is sent in its place
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URL:    redactEmails(req.URL.String()),
+		Header: redactHeader(req.Header),
+		Body:   redactBody(string(body)),
+	}
+
+	if r.recording {
+		return r.record(req, recorded)
+	}
+
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := redactHeader(resp.Header)
+	header.Del("Content-Length")
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request:  recorded,
+		Response: RecordedResponse{StatusCode: resp.StatusCode, Header: header, Body: redactBody(string(body))},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := r.match(recorded)
+	if i < 0 {
+		return nil, &UnrecordedRequestError{Method: recorded.Method, URL: recorded.URL, Cassette: r.path}
+	}
+	r.used[i] = true
+
+	rr := r.cassette.Interactions[i].Response
+	header := rr.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %v", rr.StatusCode, http.StatusText(rr.StatusCode)),
+		StatusCode:    rr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(rr.Body)),
+		ContentLength: int64(len(rr.Body)),
+		Request:       req,
+	}, nil
+}
+
+// match returns the index of the interaction replayed for the request, -1 when there is none.
+func (r *Recorder) match(req RecordedRequest) int {
+	interactions := r.cassette.Interactions
+
+	if r.Matching == MatchStrict {
+		for i, it := range interactions {
+			if r.used[i] {
+				continue
+			}
+			if it.Request.Method == req.Method && it.Request.URL == req.URL && it.Request.Body == req.Body {
+				return i
+			}
+			break
+		}
+		return -1
+	}
+
+	last := -1
+	for i, it := range interactions {
+		if it.Request.Method != req.Method || pathOf(it.Request.URL) != pathOf(req.URL) {
+			continue
+		}
+		if !r.used[i] {
+			return i
+		}
+		last = i
+	}
+
+	return last
+}
+
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range redactedHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, RedactedHeader)
+		}
+	}
+
+	return h
+}
+
+// redactBody redacts the credentials and emails of a JSON or form encoded body.
+func redactBody(s string) string {
+	s = jsonFieldPattern.ReplaceAllString(s, `${1}"`+RedactedField+`"`)
+	s = formFieldPattern.ReplaceAllString(s, "${1}${2}"+RedactedField)
+
+	return redactEmails(s)
+}
+
+func redactEmails(s string) string {
+	return emailPattern.ReplaceAllString(s, RedactedEmail)
+}
+
+func pathOf(u string) string {
+	if i := strings.IndexByte(u, '?'); i >= 0 {
+		return u[:i]
+	}
+
+	return u
+}
@@ -0,0 +1,220 @@
+package calendlytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+type RecorderTestSuite struct {
+	suite.Suite
+
+	server  *Server
+	baseURL string
+
+	// path of the cassette recorded by SetupTest
+	cassette string
+}
+
+func TestRecorderTestSuite(t *testing.T) {
+	suite.Run(t, new(RecorderTestSuite))
+}
+
+// SetupTest records a cassette of the current user and of the events booked by an invitee,
+// then stops the server so the tests run offline.
+func (suite *RecorderTestSuite) SetupTest() {
+	fixtures, err := DecodeFixtures(strings.NewReader(fixturesJSON))
+	suite.Require().NoError(err)
+	fixtures.ScheduledEvents = append(fixtures.ScheduledEvents, &calendly.ScheduledEvent{
+		URI:         "https://api.calendly.com/scheduled_events/E1",
+		Status:      calendly.EventStatusActive,
+		Memberships: []*calendly.EventMembership{{User: "https://api.calendly.com/users/U1"}},
+	})
+
+	suite.server = NewServer(testToken, fixtures)
+	suite.baseURL = suite.server.URL + "/"
+	suite.cassette = filepath.Join(suite.T().TempDir(), "testdata", "cassette.json")
+
+	rec, err := NewRecorder(suite.cassette, ModeRecord)
+	suite.Require().NoError(err)
+
+	client := suite.client(rec)
+	_, _, err = client.Users.AboutMe(context.Background())
+	suite.Require().NoError(err)
+	_, _, err = client.ScheduledEvents.List(context.Background(), suite.eventsOpts())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(rec.Close())
+	suite.server.Close()
+}
+
+func (suite *RecorderTestSuite) client(rec *Recorder) *calendly.Client {
+	client := calendly.NewClient(&http.Client{Transport: &calendly.Transport{
+		Base:   rec,
+		Source: calendly.StaticTokenSource(&calendly.Token{AccessToken: testToken}),
+	}}, calendly.WithAPIVersion(calendly.APIV2))
+	client.SetBaseURL(suite.baseURL)
+
+	return client
+}
+
+func (suite *RecorderTestSuite) eventsOpts() *calendly.ScheduledEventsOpts {
+	return &calendly.ScheduledEventsOpts{
+		User:         "https://api.calendly.com/users/U1",
+		InviteeEmail: "bob@example.com",
+	}
+}
+
+func (suite *RecorderTestSuite) TestRedaction() {
+	assert := assert.New(suite.T())
+
+	data, err := ioutil.ReadFile(suite.cassette)
+
+	assert.NoError(err)
+	assert.NotContains(string(data), testToken)
+	assert.NotContains(string(data), "jane@example.com")
+	assert.NotContains(string(data), "bob%40example.com")
+	assert.Contains(string(data), RedactedHeader)
+	assert.Contains(string(data), RedactedEmail)
+}
+
+func (suite *RecorderTestSuite) TestRedactionOfSecrets() {
+	assert := assert.New(suite.T())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"token_type":"Bearer","access_token":"access-secret","refresh_token":"refresh-secret","expires_in":7200}`)
+	})
+	mux.HandleFunc("/webhook_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/webhook_subscriptions/W1",`+
+			`"callback_url":"https://example.com/hook","scope":"organization","signing_key":"signing-secret"}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	suite.baseURL = server.URL + "/"
+
+	path := filepath.Join(suite.T().TempDir(), "secrets.json")
+	rec, err := NewRecorder(path, ModeRecord)
+	assert.NoError(err)
+
+	oauth := &calendly.OAuthConfig{
+		ClientID:     "client",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://example.com/callback",
+		AuthBaseURL:  server.URL,
+		HTTPClient:   &http.Client{Transport: rec},
+	}
+	token, err := oauth.Exchange(context.Background(), "code-secret")
+	assert.NoError(err)
+	assert.Equal("access-secret", token.AccessToken)
+
+	wh, _, err := suite.client(rec).Webhooks.Create(context.Background(), &calendly.WebhooksOpts{
+		Url:          "https://example.com/hook",
+		Events:       []calendly.EventHookType{calendly.InviteeCreatedHookType},
+		Scope:        calendly.WebhookScopeOrganization,
+		Organization: "https://api.calendly.com/organizations/O1",
+		SigningKey:   "signing-secret",
+	})
+	assert.NoError(err)
+	assert.Equal("https://api.calendly.com/webhook_subscriptions/W1", wh.URI)
+	assert.NoError(rec.Close())
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(err)
+	for _, secret := range []string{"client-secret", "code-secret", "access-secret", "refresh-secret", "signing-secret"} {
+		assert.NotContains(string(data), secret)
+	}
+	assert.Contains(string(data), "client_id=client")
+	assert.Contains(string(data), `https://example.com/hook`)
+
+	// the redacted requests still match while replaying
+	rec, err = NewRecorder(path, ModeReplay)
+	assert.NoError(err)
+	oauth.HTTPClient = &http.Client{Transport: rec}
+	token, err = oauth.Exchange(context.Background(), "code-secret")
+	assert.NoError(err)
+	assert.Equal(RedactedField, token.AccessToken)
+}
+
+func (suite *RecorderTestSuite) TestReplayStrict() {
+	assert := assert.New(suite.T())
+	rec, err := NewRecorder(suite.cassette, ModeReplay)
+	assert.NoError(err)
+	client := suite.client(rec)
+
+	me, _, err := client.Users.AboutMe(context.Background())
+
+	assert.NoError(err)
+	assert.Equal("https://api.calendly.com/users/U1", me.ID)
+	assert.Equal(RedactedEmail, me.Attributes.Email)
+
+	events, _, err := client.ScheduledEvents.List(context.Background(), suite.eventsOpts())
+
+	assert.NoError(err)
+	assert.Len(events, 1)
+
+	_, _, err = client.Users.AboutMe(context.Background())
+
+	assert.True(errors.Is(err, ErrUnrecordedRequest))
+	assert.Contains(err.Error(), suite.cassette)
+}
+
+func (suite *RecorderTestSuite) TestReplayStrictOrder() {
+	rec, _ := NewRecorder(suite.cassette, ModeReplay)
+
+	_, _, err := suite.client(rec).ScheduledEvents.List(context.Background(), suite.eventsOpts())
+
+	var uerr *UnrecordedRequestError
+	assert.True(suite.T(), errors.As(err, &uerr))
+	assert.Equal(suite.T(), http.MethodGet, uerr.Method)
+}
+
+func (suite *RecorderTestSuite) TestReplayLenient() {
+	assert := assert.New(suite.T())
+	rec, _ := NewRecorder(suite.cassette, ModeReplay)
+	rec.Matching = MatchLenient
+	client := suite.client(rec)
+
+	events, _, err := client.ScheduledEvents.List(context.Background(), &calendly.ScheduledEventsOpts{
+		Organization: "https://api.calendly.com/organizations/O1",
+	})
+
+	assert.NoError(err)
+	assert.Len(events, 1)
+
+	for i := 0; i < 2; i++ {
+		_, _, err = client.Users.AboutMe(context.Background())
+		assert.NoError(err)
+	}
+
+	_, _, err = client.EventTypes.Get(context.Background(), "ET1")
+	assert.True(errors.Is(err, ErrUnrecordedRequest))
+}
+
+func (suite *RecorderTestSuite) TestModes() {
+	assert := assert.New(suite.T())
+	missing := filepath.Join(suite.T().TempDir(), "missing.json")
+
+	_, err := NewRecorder(missing, ModeReplay)
+	assert.Error(err)
+
+	rec, err := NewRecorder(missing, ModeReplayOrRecord)
+	assert.NoError(err)
+	assert.True(rec.Recording())
+
+	rec, err = NewRecorder(suite.cassette, ModeReplayOrRecord)
+	assert.NoError(err)
+	assert.False(rec.Recording())
+}
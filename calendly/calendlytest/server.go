@@ -0,0 +1,436 @@
+/*
+Package calendlytest provides a stateful in-memory fake of the Calendly API v2, for testing
+code built on the calendly package and for developing offline.
+
+The fake serves users, event types, scheduled events, invitees and webhook subscriptions
+seeded from Fixtures, with the pagination, filters and errors of the real API:
+
+	srv := calendlytest.NewServer("token", fixtures)
+	defer srv.Close()
+
+	client := srv.Client()
+	events, err := calendly.ListAll(ctx, client.ScheduledEvents.Iter(opt))
+
+A client built elsewhere can be pointed at the fake with SetBaseURL(srv.URL + "/").
+Requests without the bearer token fail with 401 Unauthorized, and RateLimit and FailNext
+make the following requests fail to exercise error handling and retries.
+
+A Recorder records the interactions of a client with the real API to a cassette file, with
+credentials and email addresses redacted, and replays them in later test runs.
+*/
+package calendlytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+// BaseURI prefixes the URIs of the resources created by the server, as it does with the real API.
+const BaseURI = "https://api.calendly.com"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	defaultOrganization = BaseURI + "/organizations/AAAAAAAAAAAAAAAA"
+)
+
+// DefaultUser is the current user of a server seeded without users.
+var DefaultUser = &User{
+	URI:                 BaseURI + "/users/AAAAAAAAAAAAAAAA",
+	Name:                "Test User",
+	Slug:                "test-user",
+	Email:               "test@example.com",
+	SchedulingURL:       "https://calendly.com/test-user",
+	Timezone:            "UTC",
+	CurrentOrganization: defaultOrganization,
+}
+
+// Server is a fake Calendly API v2 server.
+type Server struct {
+	// Base URL of the server, e.g. http://127.0.0.1:50000
+	URL string
+
+	token  string
+	server *httptest.Server
+	now    func() time.Time
+
+	mu       sync.Mutex
+	state    Fixtures
+	failures []failure
+	requests int
+	lastID   int
+}
+
+// failure is a response the server sends instead of handling a request.
+type failure struct {
+	status int
+	reset  time.Duration
+}
+
+// NewServer starts a server requiring the given bearer token, any token being accepted when it
+// is empty, and seeds it with fixtures. The server must be closed when done.
+func NewServer(token string, fixtures *Fixtures) *Server {
+	s := &Server{token: token, now: time.Now}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+
+	s.Seed(fixtures)
+	if len(s.state.Users) == 0 {
+		u := *DefaultUser
+		s.state.Users = append(s.state.Users, &u)
+	}
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns an API v2 client authenticated with the token of the server and pointed at it.
+func (s *Server) Client(opts ...calendly.ClientOpt) *calendly.Client {
+	token := s.token
+	if token == "" {
+		token = "calendlytest"
+	}
+
+	opts = append([]calendly.ClientOpt{calendly.WithAPIVersion(calendly.APIV2)}, opts...)
+	client := calendly.NewClient(calendly.NewBearerAuthClient(token), opts...)
+	client.SetBaseURL(s.URL + "/")
+
+	return client
+}
+
+// Seed adds the fixtures to the state of the server, replacing the resources with the same URI.
+func (s *Server) Seed(f *Fixtures) {
+	if f == nil {
+		return
+	}
+
+	f = f.clone()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range f.Users {
+		s.state.Users = upsert(s.state.Users, u, u.URI)
+	}
+	for _, et := range f.EventTypes {
+		s.state.EventTypes = upsert(s.state.EventTypes, et, et.URI)
+	}
+	for _, e := range f.ScheduledEvents {
+		s.state.ScheduledEvents = upsert(s.state.ScheduledEvents, e, e.URI)
+	}
+	for _, i := range f.Invitees {
+		s.state.Invitees = upsert(s.state.Invitees, i, i.URI)
+	}
+	for _, w := range f.Webhooks {
+		s.state.Webhooks = upsert(s.state.Webhooks, w, w.URI)
+	}
+}
+
+// State returns a copy of the resources held by the server, including the changes made by requests.
+func (s *Server) State() *Fixtures {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.state.clone()
+}
+
+// Requests returns the number of requests the server received.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// RateLimit makes the next n requests fail with 429 Too Many Requests, reporting that the rate
+// limit window resets after reset.
+func (s *Server) RateLimit(n int, reset time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < n; i++ {
+		s.failures = append(s.failures, failure{status: http.StatusTooManyRequests, reset: reset})
+	}
+}
+
+// FailNext makes the next n requests fail with the given status code.
+func (s *Server) FailNext(status, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < n; i++ {
+		s.failures = append(s.failures, failure{status: status})
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", strconv.Itoa(s.requests))
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "Unauthenticated", "The access token is invalid")
+		return
+	}
+
+	if len(s.failures) > 0 {
+		f := s.failures[0]
+		s.failures = s.failures[1:]
+		s.fail(w, f)
+		return
+	}
+
+	s.route(w, r, strings.Split(strings.Trim(r.URL.Path, "/"), "/"))
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		return false
+	}
+
+	return s.token == "" || token == s.token
+}
+
+func (s *Server) fail(w http.ResponseWriter, f failure) {
+	if f.status != http.StatusTooManyRequests {
+		writeError(w, f.status, http.StatusText(f.status), "Failure injected by calendlytest")
+		return
+	}
+
+	reset := strconv.Itoa(int(f.reset / time.Second))
+	w.Header().Set("X-RateLimit-Limit", "60")
+	w.Header().Set("X-RateLimit-Remaining", "0")
+	w.Header().Set("X-RateLimit-Reset", reset)
+	w.Header().Set("Retry-After", reset)
+	writeError(w, http.StatusTooManyRequests, "Too Many Requests", "Rate limit exceeded")
+}
+
+// route dispatches a request by its path segments.
+func (s *Server) route(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case match(r, path, http.MethodGet, "users", "me"):
+		s.getUser(w, s.me().URI)
+	case match(r, path, http.MethodGet, "users", ""):
+		s.getUser(w, BaseURI+"/users/"+path[1])
+	case match(r, path, http.MethodGet, "event_types"):
+		s.listEventTypes(w, r)
+	case match(r, path, http.MethodGet, "event_types", ""):
+		s.getEventType(w, path[1])
+	case match(r, path, http.MethodGet, "scheduled_events"):
+		s.listScheduledEvents(w, r)
+	case match(r, path, http.MethodGet, "scheduled_events", ""):
+		s.getScheduledEvent(w, path[1])
+	case match(r, path, http.MethodPost, "scheduled_events", "", "cancellation"):
+		s.cancelScheduledEvent(w, r, path[1])
+	case match(r, path, http.MethodGet, "scheduled_events", "", "invitees"):
+		s.listInvitees(w, r, path[1])
+	case match(r, path, http.MethodGet, "scheduled_events", "", "invitees", ""):
+		s.getInvitee(w, path[1], path[3])
+	case match(r, path, http.MethodGet, "webhook_subscriptions"):
+		s.listWebhooks(w, r)
+	case match(r, path, http.MethodPost, "webhook_subscriptions"):
+		s.createWebhook(w, r)
+	case match(r, path, http.MethodGet, "webhook_subscriptions", ""):
+		s.getWebhook(w, path[1])
+	case match(r, path, http.MethodDelete, "webhook_subscriptions", ""):
+		s.deleteWebhook(w, path[1])
+	default:
+		notFound(w)
+	}
+}
+
+// match reports whether the request has the method and path, empty segments matching any UUID.
+func match(r *http.Request, path []string, method string, segments ...string) bool {
+	if r.Method != method || len(path) != len(segments) {
+		return false
+	}
+
+	for i, seg := range segments {
+		if seg == "" && path[i] == "" || seg != "" && seg != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// me returns the owner of the token.
+func (s *Server) me() *User {
+	return s.state.Users[0]
+}
+
+func (s *Server) newURI(collection string) string {
+	s.lastID++
+	return fmt.Sprintf("%v/%v/%016X", BaseURI, collection, s.lastID)
+}
+
+// upsert replaces the item of items with the given URI, or appends it.
+func upsert[T any](items []*T, item *T, uri string) []*T {
+	for i, it := range items {
+		if uriOf(it) == uri {
+			items[i] = item
+			return items
+		}
+	}
+
+	return append(items, item)
+}
+
+// find returns the item of items with the given UUID.
+func find[T any](items []*T, uuid string) (int, *T) {
+	for i, it := range items {
+		if calendly.UUIDFromURI(uriOf(it)) == uuid {
+			return i, it
+		}
+	}
+
+	return -1, nil
+}
+
+func uriOf(item interface{}) string {
+	switch it := item.(type) {
+	case *User:
+		return it.URI
+	case *EventType:
+		return it.URI
+	case *calendly.ScheduledEvent:
+		return it.URI
+	case *calendly.Invitee:
+		return it.URI
+	case *WebhookSubscription:
+		return it.URI
+	}
+
+	return ""
+}
+
+type resourceResponse struct {
+	Resource interface{} `json:"resource"`
+}
+
+type collectionResponse struct {
+	Collection interface{}          `json:"collection"`
+	Pagination *calendly.Pagination `json:"pagination"`
+}
+
+type errorResponse struct {
+	Title   string                  `json:"title"`
+	Message string                  `json:"message"`
+	Details []*calendly.ErrorDetail `json:"details,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeResource(w http.ResponseWriter, status int, resource interface{}) {
+	writeJSON(w, status, &resourceResponse{Resource: resource})
+}
+
+func writeError(w http.ResponseWriter, status int, title, message string) {
+	writeJSON(w, status, &errorResponse{Title: title, Message: message})
+}
+
+// invalidArgument writes a 400 Bad Request listing the invalid parameters as pairs of names and messages.
+func invalidArgument(w http.ResponseWriter, parameterMessages ...string) {
+	e := &errorResponse{Title: "Invalid Argument", Message: "The supplied parameters are invalid."}
+	for i := 0; i+1 < len(parameterMessages); i += 2 {
+		e.Details = append(e.Details, &calendly.ErrorDetail{
+			Parameter: parameterMessages[i],
+			Message:   parameterMessages[i+1],
+		})
+	}
+
+	writeJSON(w, http.StatusBadRequest, e)
+}
+
+func notFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Resource Not Found", "The server could not find the requested resource.")
+}
+
+// paginate writes the page of items selected by the count and page_token parameters.
+// Page tokens are the offsets of the pages.
+func paginate[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	q := r.URL.Query()
+
+	count := defaultPageSize
+	if v := q.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			invalidArgument(w, "count", fmt.Sprintf("must be between 1 and %v", maxPageSize))
+			return
+		}
+		count = n
+	}
+
+	offset := 0
+	if v := q.Get("page_token"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > len(items) {
+			invalidArgument(w, "page_token", "is invalid")
+			return
+		}
+		offset = n
+	}
+
+	end := offset + count
+	if end > len(items) {
+		end = len(items)
+	}
+
+	page := items[offset:end]
+	p := &calendly.Pagination{Count: len(page)}
+	if end < len(items) {
+		p.NextPageToken = strconv.Itoa(end)
+		p.NextPage = pageURL(r, p.NextPageToken)
+	}
+	if offset > 0 {
+		prev := offset - count
+		if prev < 0 {
+			prev = 0
+		}
+		p.PreviousPageToken = strconv.Itoa(prev)
+		p.PreviousPage = pageURL(r, p.PreviousPageToken)
+	}
+
+	writeJSON(w, http.StatusOK, &collectionResponse{Collection: page, Pagination: p})
+}
+
+func pageURL(r *http.Request, token string) string {
+	q := r.URL.Query()
+	q.Set("page_token", token)
+
+	return BaseURI + r.URL.Path + "?" + q.Encode()
+}
+
+// sortDirection parses a sort parameter of the form "field:asc" or "field:desc".
+func sortDirection(w http.ResponseWriter, r *http.Request, field string) (desc, ok bool) {
+	v := r.URL.Query().Get("sort")
+	switch v {
+	case "", field + ":asc":
+		return false, true
+	case field + ":desc":
+		return true, true
+	}
+
+	invalidArgument(w, "sort", fmt.Sprintf("must be %v:asc or %v:desc", field, field))
+	return false, false
+}
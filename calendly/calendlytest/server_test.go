@@ -0,0 +1,260 @@
+package calendlytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+const testToken = "secret"
+
+const fixturesJSON = `{
+	"users": [
+		{"uri": "https://api.calendly.com/users/U1", "name": "Jane", "email": "jane@example.com",
+		 "timezone": "Europe/London", "current_organization": "https://api.calendly.com/organizations/O1"},
+		{"uri": "https://api.calendly.com/users/U2", "name": "John", "email": "john@example.com",
+		 "timezone": "UTC", "current_organization": "https://api.calendly.com/organizations/O1"}
+	],
+	"event_types": [
+		{"uri": "https://api.calendly.com/event_types/ET1", "name": "Intro", "active": true, "duration": 15,
+		 "kind": "solo", "profile": {"type": "User", "name": "Jane", "owner": "https://api.calendly.com/users/U1"}},
+		{"uri": "https://api.calendly.com/event_types/ET2", "name": "Demo", "active": false, "duration": 60,
+		 "kind": "solo", "profile": {"type": "User", "name": "Jane", "owner": "https://api.calendly.com/users/U1"}},
+		{"uri": "https://api.calendly.com/event_types/ET3", "name": "Sync", "active": true, "duration": 30,
+		 "kind": "solo", "profile": {"type": "User", "name": "John", "owner": "https://api.calendly.com/users/U2"}}
+	],
+	"invitees": [
+		{"uri": "https://api.calendly.com/scheduled_events/E1/invitees/I1", "email": "bob@example.com",
+		 "name": "Bob", "status": "active", "event": "https://api.calendly.com/scheduled_events/E1"}
+	]
+}`
+
+type ServerTestSuite struct {
+	suite.Suite
+
+	server *Server
+	client *calendly.Client
+}
+
+func TestServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+func (suite *ServerTestSuite) SetupTest() {
+	fixtures, err := DecodeFixtures(strings.NewReader(fixturesJSON))
+	suite.Require().NoError(err)
+
+	start := time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i := 1; i <= 25; i++ {
+		fixtures.ScheduledEvents = append(fixtures.ScheduledEvents, &calendly.ScheduledEvent{
+			URI:         fmt.Sprintf("https://api.calendly.com/scheduled_events/E%d", i),
+			Name:        "Intro",
+			Status:      calendly.EventStatusActive,
+			StartTime:   start.Add(time.Duration(i) * time.Hour),
+			EndTime:     start.Add(time.Duration(i)*time.Hour + 15*time.Minute),
+			EventType:   "https://api.calendly.com/event_types/ET1",
+			Memberships: []*calendly.EventMembership{{User: "https://api.calendly.com/users/U1"}},
+		})
+	}
+
+	suite.server = NewServer(testToken, fixtures)
+	suite.client = suite.server.Client()
+}
+
+func (suite *ServerTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *ServerTestSuite) TestAboutMe() {
+	assert := assert.New(suite.T())
+
+	me, _, err := suite.client.Users.AboutMe(context.Background())
+
+	assert.NoError(err)
+	assert.Equal("https://api.calendly.com/users/U1", me.ID)
+	assert.Equal("jane@example.com", me.Attributes.Email)
+	assert.Equal("Europe/London", me.Attributes.Timezone.String())
+}
+
+func (suite *ServerTestSuite) TestDefaultUser() {
+	assert := assert.New(suite.T())
+	server := NewServer("", nil)
+	defer server.Close()
+
+	me, _, err := server.Client().Users.AboutMe(context.Background())
+
+	assert.NoError(err)
+	assert.Equal(DefaultUser.URI, me.ID)
+}
+
+func (suite *ServerTestSuite) TestEventTypesFilters() {
+	assert := assert.New(suite.T())
+	active := true
+
+	eventTypes, _, err := suite.client.EventTypes.List(context.Background(), &calendly.EventTypesOpts{Active: &active})
+
+	assert.NoError(err)
+	assert.Len(eventTypes, 1)
+	assert.Equal("Intro", eventTypes[0].Attributes.Name)
+	assert.Equal(15*time.Minute, eventTypes[0].Attributes.Duration)
+
+	eventTypes, _, err = suite.client.EventTypes.List(context.Background(), &calendly.EventTypesOpts{
+		Organization: "https://api.calendly.com/organizations/O1",
+	})
+
+	assert.NoError(err)
+	assert.Len(eventTypes, 3)
+	assert.Equal("Demo", eventTypes[0].Attributes.Name)
+}
+
+func (suite *ServerTestSuite) TestScheduledEventsPagination() {
+	assert := assert.New(suite.T())
+	opt := &calendly.ScheduledEventsOpts{
+		User:        "https://api.calendly.com/users/U1",
+		Sort:        "start_time:desc",
+		ListOptions: calendly.ListOptions{Count: 10},
+	}
+
+	events, resp, err := suite.client.ScheduledEvents.List(context.Background(), opt)
+
+	assert.NoError(err)
+	assert.Len(events, 10)
+	assert.Equal("https://api.calendly.com/scheduled_events/E25", events[0].URI)
+	assert.Equal("10", resp.NextPageToken)
+
+	all, err := calendly.ListAll(context.Background(), suite.client.ScheduledEvents.Iter(opt))
+
+	assert.NoError(err)
+	assert.Len(all, 25)
+	assert.Equal(4, suite.server.Requests())
+}
+
+func (suite *ServerTestSuite) TestScheduledEventsValidation() {
+	assert := assert.New(suite.T())
+
+	_, _, err := suite.client.ScheduledEvents.List(context.Background(), &calendly.ScheduledEventsOpts{})
+
+	var verr *calendly.ValidationError
+	assert.True(errors.As(err, &verr))
+	assert.Equal("user", verr.Details[0].Parameter)
+}
+
+func (suite *ServerTestSuite) TestGetNotFound() {
+	_, _, err := suite.client.ScheduledEvents.Get(context.Background(), "missing")
+
+	assert.True(suite.T(), errors.Is(err, calendly.ErrNotFound))
+}
+
+func (suite *ServerTestSuite) TestCancelEvent() {
+	assert := assert.New(suite.T())
+
+	c, _, err := suite.client.Invitees.CancelEvent(context.Background(), "E1", "Sick")
+
+	assert.NoError(err)
+	assert.Equal("Sick", c.Reason)
+	assert.Equal("Jane", c.CanceledBy)
+
+	invitee, _, err := suite.client.Invitees.Get(context.Background(), "E1", "I1")
+
+	assert.NoError(err)
+	assert.Equal(calendly.InviteeStatusCanceled, invitee.Status)
+
+	_, _, err = suite.client.Invitees.CancelEvent(context.Background(), "E1", "Again")
+
+	assert.True(errors.Is(err, calendly.ErrForbidden))
+	assert.Equal(calendly.EventStatusCanceled, suite.server.State().ScheduledEvents[0].Status)
+}
+
+func (suite *ServerTestSuite) TestWebhooks() {
+	assert := assert.New(suite.T())
+	ctx := context.Background()
+
+	wh, resp, err := suite.client.Webhooks.Create(ctx, &calendly.WebhooksOpts{
+		Url:        "https://example.com/hooks",
+		Events:     []calendly.EventHookType{calendly.InviteeCreatedHookType},
+		SigningKey: "key",
+	})
+
+	assert.NoError(err)
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+	assert.Equal(calendly.WebhookScopeUser, wh.Attributes.Scope)
+	assert.Equal("https://api.calendly.com/users/U1", wh.Attributes.User)
+	assert.Equal("key", suite.server.State().Webhooks[0].SigningKey)
+
+	_, _, err = suite.client.Webhooks.Create(ctx, &calendly.WebhooksOpts{
+		Url:    "https://example.com/hooks",
+		Events: []calendly.EventHookType{calendly.InviteeCanceledHookType},
+	})
+
+	var errResp *calendly.ErrorResponse
+	assert.True(errors.As(err, &errResp))
+	assert.Equal(http.StatusConflict, errResp.Response.StatusCode)
+
+	webhooks, _, err := suite.client.Webhooks.ListSubscriptions(ctx, nil)
+
+	assert.NoError(err)
+	assert.Len(webhooks, 1)
+
+	_, err = suite.client.Webhooks.DeleteByURI(ctx, wh.URI)
+
+	assert.NoError(err)
+	assert.Empty(suite.server.State().Webhooks)
+}
+
+func (suite *ServerTestSuite) TestUnauthorized() {
+	client := calendly.NewClient(calendly.NewBearerAuthClient("wrong"), calendly.WithAPIVersion(calendly.APIV2))
+	client.SetBaseURL(suite.server.URL + "/")
+
+	_, _, err := client.Users.AboutMe(context.Background())
+
+	assert.True(suite.T(), errors.Is(err, calendly.ErrUnauthorized))
+}
+
+func (suite *ServerTestSuite) TestRateLimit() {
+	assert := assert.New(suite.T())
+	suite.server.RateLimit(1, 30*time.Second)
+
+	_, _, err := suite.client.Users.AboutMe(context.Background())
+
+	var rerr *calendly.RateLimitError
+	assert.True(errors.As(err, &rerr))
+	assert.Equal(0, rerr.Rate.Remaining)
+	assert.True(time.Until(rerr.Rate.Reset) > 20*time.Second)
+
+	_, _, err = suite.client.Users.AboutMe(context.Background())
+
+	assert.NoError(err)
+}
+
+func (suite *ServerTestSuite) TestRetries() {
+	assert := assert.New(suite.T())
+	client := suite.server.Client(calendly.WithRetryPolicy(calendly.RetryPolicy{MaxAttempts: 3}))
+	suite.server.RateLimit(1, 0)
+	suite.server.FailNext(http.StatusBadGateway, 1)
+
+	_, _, err := client.Users.AboutMe(context.Background())
+
+	assert.NoError(err)
+	assert.Equal(3, suite.server.Requests())
+}
+
+func (suite *ServerTestSuite) TestSeedReplaces() {
+	assert := assert.New(suite.T())
+	suite.server.Seed(&Fixtures{EventTypes: []*EventType{
+		{URI: "https://api.calendly.com/event_types/ET1", Name: "Renamed"},
+	}})
+
+	et, _, err := suite.client.EventTypes.Get(context.Background(), "ET1")
+
+	assert.NoError(err)
+	assert.Equal("Renamed", et.Attributes.Name)
+	assert.Len(suite.server.State().EventTypes, 3)
+}
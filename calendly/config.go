@@ -1,20 +1,124 @@
 package calendly
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
+	"time"
 )
 
-const DefaultHeaderTokenKey = "X-Token"
+const (
+	DefaultHeaderTokenKey = "X-Token"
 
-// Config represents an OAuth1 consumer's (client's) key and secret, the
-// callback URL, and the provider Endpoint to which the consumer corresponds.
+	// Header and token type used by API v2 bearer tokens
+	BearerHeaderTokenKey = "Authorization"
+	BearerTokenType      = "Bearer"
+)
+
+var errMissingToken = errors.New("go-calendly: API Key token is missing")
+
+// Token authorizes requests. It is either an OAuth 2.0 token pair issued by Calendly,
+// an API v2 personal access token or a v1 API key.
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+
+	// Header carrying the token, Authorization when empty
+	Header string `json:"-"`
+
+	// URIs of the user and organization that authorized the application
+	Owner        string `json:"owner,omitempty"`
+	Organization string `json:"organization,omitempty"`
+
+	// Time at which the access token expires, zero if it does not
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the access token is set and not about to expire.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+
+	return t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+// SetAuthHeader sets the header authorizing the request. Tokens carried by the Authorization
+// header default to the Bearer type.
+func (t *Token) SetAuthHeader(r *http.Request) {
+	header, typ := t.Header, t.TokenType
+	if header == "" {
+		header = BearerHeaderTokenKey
+	}
+	if header == BearerHeaderTokenKey && typ == "" {
+		typ = BearerTokenType
+	}
+
+	value := t.AccessToken
+	if typ != "" {
+		value = typ + " " + value
+	}
+
+	r.Header.Set(header, value)
+}
+
+// TokenSource supplies the token authorizing requests. It abstracts over the v1 API keys,
+// the API v2 personal access tokens and the OAuth 2.0 access tokens.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns a valid token, refreshing it first if needed.
+	Token(ctx context.Context) (*Token, error)
+}
+
+// StaticTokenSource returns a TokenSource always returning the same token.
+func StaticTokenSource(t *Token) TokenSource {
+	return staticTokenSource{t}
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	if s.token == nil || s.token.AccessToken == "" {
+		return nil, errMissingToken
+	}
+
+	return s.token, nil
+}
+
+// Config represents the API key used to authenticate requests and the header carrying it.
+// It is a TokenSource. See OAuthConfig for authenticating with OAuth 2.0.
 type Config struct {
 	// API Key (Client Identifier)
 	ApiKey string
 
-	// Header identifier for passing the API key
+	// Header identifier for passing the API key, DefaultHeaderTokenKey when empty
 	HeaderKey string
+
+	// Optional scheme prepended to the API key in the header value, e.g. "Bearer"
+	TokenType string
+}
+
+// Token returns the API key of the config as a token.
+func (c *Config) Token(ctx context.Context) (*Token, error) {
+	if c.ApiKey == "" {
+		return nil, errMissingToken
+	}
+
+	header := c.HeaderKey
+	if header == "" {
+		header = DefaultHeaderTokenKey
+	}
+
+	return &Token{AccessToken: c.ApiKey, TokenType: c.TokenType, Header: header}, nil
+}
+
+// NewAuthClient returns a new http Client which signs requests with the tokens of src.
+func NewAuthClient(src TokenSource) *http.Client {
+	return &http.Client{Transport: &Transport{Base: http.DefaultTransport, Source: src}}
 }
 
 // NewTokenAuthClient returns a new http Client which signs requests via header Token.
@@ -22,9 +126,17 @@ func NewTokenAuthClient(config *Config) *http.Client {
 	return &http.Client{Transport: &Transport{Base: http.DefaultTransport, config: config}}
 }
 
+// NewBearerAuthClient returns a new http Client which signs requests with an
+// "Authorization: Bearer" header as expected by API v2, e.g. with a personal access token.
+func NewBearerAuthClient(token string) *http.Client {
+	return NewAuthClient(StaticTokenSource(&Token{AccessToken: token, TokenType: BearerTokenType}))
+}
+
 // Transport is an http.RoundTripper which makes Authenticated HTTP requests. It
-// wraps a base RoundTripper and adds an API header using the
-// token from the config.
+// wraps a base RoundTripper and adds an authentication header using the
+// token from its source. It is safe for concurrent use.
+//
+// Requests are failed with an *AuthError, before being sent, when no token can be obtained.
 //
 // Transport is a low-level component, most users should use NewClient to create
 // an http.Client instead.
@@ -33,24 +145,34 @@ type Transport struct {
 	// http.DefaultTransport is used
 	Base http.RoundTripper
 
-	// Config that is used for this transport
+	// Source of the tokens authorizing the requests
+	Source TokenSource
+
+	// Config that is used for this transport when Source is nil
 	config *Config
 }
 
-// RoundTrip authorizes the request by passing the API token to the request header
+// RoundTrip authorizes a copy of the request with the token of the source and sends it
 func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if t.config == nil {
-		return nil, fmt.Errorf("go-calendly: Transport's config is nil")
+	src := t.Source
+	if src == nil {
+		if t.config == nil {
+			return nil, &AuthError{Err: errors.New("go-calendly: Transport's config is nil")}
+		}
+		src = t.config
 	}
 
-	if t.config.HeaderKey == "" {
-		t.config.HeaderKey = DefaultHeaderTokenKey
+	token, err := src.Token(req.Context())
+	if err != nil {
+		return nil, &AuthError{Err: err}
 	}
 
-	if t.config.ApiKey == "" {
-		return nil, fmt.Errorf("go-calendly: API Key token is missing")
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
 	}
 
-	req.Header.Set(t.config.HeaderKey, t.config.ApiKey)
-	return t.Base.RoundTrip(req)
+	r := req.Clone(req.Context())
+	token.SetAuthHeader(r)
+	return base.RoundTrip(r)
 }
@@ -0,0 +1,128 @@
+package calendly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestTransport_APIKey() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("key", r.Header.Get(DefaultHeaderTokenKey))
+		assert.Empty(r.Header.Get(BearerHeaderTokenKey))
+		fmt.Fprint(w, `{"email":"echo@echo.com"}`)
+	})
+
+	client := NewClient(NewTokenAuthClient(&Config{ApiKey: "key"}))
+	client.BaseURL = suite.client.BaseURL
+
+	_, _, err := client.Echo(context.Background())
+	assert.Nil(err)
+}
+
+func (suite *CalendlyClientTestSuite) TestTransport_CustomHeader() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Token key", r.Header.Get("X-Custom"))
+		fmt.Fprint(w, `{"email":"echo@echo.com"}`)
+	})
+
+	config := &Config{ApiKey: "key", HeaderKey: "X-Custom", TokenType: "Token"}
+	client := NewClient(NewTokenAuthClient(config))
+	client.BaseURL = suite.client.BaseURL
+
+	_, _, err := client.Echo(context.Background())
+	assert.Nil(err)
+	assert.Equal("X-Custom", config.HeaderKey)
+}
+
+func (suite *CalendlyClientTestSuite) TestTransport_Bearer() {
+	assert := assert.New(suite.T())
+
+	var mu sync.Mutex
+	var headers []string
+	suite.mux.HandleFunc("/"+aboutMePath, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Get(BearerHeaderTokenKey))
+		mu.Unlock()
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/users/U1","email":"me@example.com"}}`)
+	})
+
+	client := NewClient(NewBearerAuthClient("pat"), WithAPIVersion(APIV2))
+	client.SetBaseURL(suite.server.URL + "/")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(client.ValidateCredentials(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(headers, 5)
+	for _, h := range headers {
+		assert.Equal("Bearer pat", h)
+	}
+}
+
+func (suite *CalendlyClientTestSuite) TestTransport_MissingToken() {
+	assert := assert.New(suite.T())
+
+	calls := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	})
+
+	for _, authClient := range []*http.Client{NewTokenAuthClient(&Config{}), NewBearerAuthClient("")} {
+		client := NewClient(authClient, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Minute}))
+		client.BaseURL = suite.client.BaseURL
+
+		err := client.ValidateCredentials(context.Background())
+
+		var authErr *AuthError
+		assert.True(errors.As(err, &authErr))
+		assert.Nil(authErr.ErrorResponse)
+		assert.True(errors.Is(err, ErrUnauthorized))
+		assert.True(errors.Is(err, errMissingToken))
+		assert.False(errors.Is(err, ErrNetwork))
+	}
+	assert.Equal(0, calls)
+}
+
+func (suite *CalendlyClientTestSuite) TestToken_SetAuthHeader() {
+	assert := assert.New(suite.T())
+
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	(&Token{AccessToken: "a"}).SetAuthHeader(r)
+	assert.Equal("Bearer a", r.Header.Get(BearerHeaderTokenKey))
+
+	r, _ = http.NewRequest(http.MethodGet, "/", nil)
+	(&Token{AccessToken: "a", Header: DefaultHeaderTokenKey}).SetAuthHeader(r)
+	assert.Equal("a", r.Header.Get(DefaultHeaderTokenKey))
+}
+
+func (suite *CalendlyClientTestSuite) TestValidateCredentials_Unauthorized() {
+	assert := assert.New(suite.T())
+
+	suite.useAPIV2()
+	suite.mux.HandleFunc("/"+aboutMePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"title":"Unauthenticated","message":"The access token is invalid"}`)
+	})
+
+	err := suite.client.ValidateCredentials(context.Background())
+
+	var authErr *AuthError
+	assert.True(errors.As(err, &authErr))
+	assert.True(errors.Is(err, ErrUnauthorized))
+}
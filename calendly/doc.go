@@ -1,7 +1,8 @@
 /*
-go-calendly is a Go client library for accessing the [Calendly API v1](https://developer.calendly.com/docs/getting-started).
+go-calendly is a Go client library for accessing the [Calendly API v1](https://developer.calendly.com/docs/getting-started)
+and the [Calendly API v2](https://developer.calendly.com/api-docs), selected with WithAPIVersion.
 
-go-calendly requires Go version 1.8 or greater.
+go-calendly requires Go version 1.21 or greater.
 */
 
 package calendly
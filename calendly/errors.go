@@ -0,0 +1,172 @@
+package calendly
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const headerRequestID = "X-Request-Id"
+
+// Sentinel errors matched by the typed API errors with errors.Is, e.g.
+//
+//	if errors.Is(err, calendly.ErrNotFound) {
+//		// the resource does not exist
+//	}
+var (
+	ErrUnauthorized = errors.New("go-calendly: unauthorized")
+	ErrForbidden    = errors.New("go-calendly: forbidden")
+	ErrNotFound     = errors.New("go-calendly: not found")
+	ErrValidation   = errors.New("go-calendly: validation failed")
+	ErrRateLimited  = errors.New("go-calendly: rate limit exceeded")
+	ErrServer       = errors.New("go-calendly: server error")
+	ErrNetwork      = errors.New("go-calendly: network error")
+	ErrDecode       = errors.New("go-calendly: cannot decode response")
+)
+
+// ErrorDetail describes a single invalid parameter of a request, as returned by API v2.
+type ErrorDetail struct {
+	Parameter string `json:"parameter"`
+	Message   string `json:"message"`
+}
+
+// AuthError occurs when the token of the client is missing, invalid or expired (401 Unauthorized).
+// When the request could not be authorized in the first place, e.g. because no token is set or
+// the token source failed to refresh it, ErrorResponse is nil and Err holds the cause instead.
+type AuthError struct {
+	*ErrorResponse
+
+	Err error
+}
+
+// PermissionError occurs when the token is not allowed to access a resource (403 Forbidden).
+type PermissionError struct {
+	*ErrorResponse
+}
+
+// NotFoundError occurs when the requested resource does not exist (404 Not Found).
+type NotFoundError struct {
+	*ErrorResponse
+}
+
+// ValidationError occurs when the request parameters are invalid (400 Bad Request or
+// 422 Unprocessable Entity). Details lists the offending parameters with API v2.
+type ValidationError struct {
+	*ErrorResponse
+}
+
+// RateLimitError occurs when Calendly returns 429 Too Many Requests.
+type RateLimitError struct {
+	*ErrorResponse
+
+	// Rate limit reported by the response
+	Rate Rate
+}
+
+// ServerError occurs when Calendly fails to handle a request (5xx).
+type ServerError struct {
+	*ErrorResponse
+}
+
+// NetworkError occurs when a request cannot be sent or its response cannot be read.
+type NetworkError struct {
+	// Number of retries made before giving up
+	Retries int
+
+	Err error
+}
+
+// DecodeError occurs when the body of a successful response cannot be decoded.
+type DecodeError struct {
+	// HTTP response whose body could not be decoded
+	Response *http.Response
+
+	Err error
+}
+
+// The typed API errors unwrap to their *ErrorResponse, so errors.As can extract it from any of them.
+func (e *AuthError) Is(target error) bool       { return target == ErrUnauthorized }
+func (e *PermissionError) Is(target error) bool { return target == ErrForbidden }
+func (e *PermissionError) Unwrap() error        { return e.ErrorResponse }
+func (e *NotFoundError) Is(target error) bool   { return target == ErrNotFound }
+func (e *NotFoundError) Unwrap() error          { return e.ErrorResponse }
+func (e *ValidationError) Is(target error) bool { return target == ErrValidation }
+func (e *ValidationError) Unwrap() error        { return e.ErrorResponse }
+func (e *RateLimitError) Is(target error) bool  { return target == ErrRateLimited }
+func (e *RateLimitError) Unwrap() error         { return e.ErrorResponse }
+func (e *ServerError) Is(target error) bool     { return target == ErrServer }
+func (e *ServerError) Unwrap() error            { return e.ErrorResponse }
+func (e *NetworkError) Is(target error) bool    { return target == ErrNetwork }
+func (e *NetworkError) Unwrap() error           { return e.Err }
+func (e *DecodeError) Is(target error) bool     { return target == ErrDecode }
+func (e *DecodeError) Unwrap() error            { return e.Err }
+
+// Unwrap returns the *ErrorResponse of a rejected request, or the cause of an unauthorized one.
+func (e *AuthError) Unwrap() error {
+	if e.ErrorResponse == nil {
+		return e.Err
+	}
+
+	return e.ErrorResponse
+}
+
+func (e *AuthError) Error() string {
+	if e.ErrorResponse == nil {
+		return fmt.Sprintf("go-calendly: cannot authorize request: %v", e.Err)
+	}
+
+	return e.ErrorResponse.Error()
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Details) == 0 {
+		return e.ErrorResponse.Error()
+	}
+
+	b := bytes.NewBufferString(e.ErrorResponse.Error())
+	for _, d := range e.Details {
+		b.WriteString(fmt.Sprintf("; %v: %v", d.Parameter, d.Message))
+	}
+
+	return b.String()
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Rate.Reset.IsZero() {
+		return fmt.Sprintf("%v rate limit exceeded", e.ErrorResponse.Error())
+	}
+
+	return fmt.Sprintf("%v rate limit exceeded, resets in %v",
+		e.ErrorResponse.Error(), time.Until(e.Rate.Reset).Round(time.Second))
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("go-calendly: network error: %v", e.Err)
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v %v: %d cannot decode response: %v",
+		e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Err)
+}
+
+// typedError returns the typed error matching the status code of an error response.
+func typedError(r *ErrorResponse) error {
+	switch c := r.Response.StatusCode; {
+	case c == http.StatusBadRequest || c == http.StatusUnprocessableEntity:
+		return &ValidationError{r}
+	case c == http.StatusUnauthorized:
+		return &AuthError{ErrorResponse: r}
+	case c == http.StatusForbidden:
+		return &PermissionError{r}
+	case c == http.StatusNotFound:
+		return &NotFoundError{r}
+	case c == http.StatusTooManyRequests:
+		return &RateLimitError{ErrorResponse: r, Rate: parseRate(r.Response)}
+	case c >= 500:
+		return &ServerError{r}
+	}
+
+	return r
+}
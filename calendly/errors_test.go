@@ -0,0 +1,107 @@
+package calendly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestCheckResponse_typedErrors() {
+	assert := assert.New(suite.T())
+	testCases := []struct {
+		status   int
+		sentinel error
+		target   interface{}
+	}{
+		{http.StatusBadRequest, ErrValidation, new(*ValidationError)},
+		{http.StatusUnprocessableEntity, ErrValidation, new(*ValidationError)},
+		{http.StatusUnauthorized, ErrUnauthorized, new(*AuthError)},
+		{http.StatusForbidden, ErrForbidden, new(*PermissionError)},
+		{http.StatusNotFound, ErrNotFound, new(*NotFoundError)},
+		{http.StatusTooManyRequests, ErrRateLimited, new(*RateLimitError)},
+		{http.StatusBadGateway, ErrServer, new(*ServerError)},
+	}
+
+	status := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "req-1")
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"title":"Failure","message":"Something failed"}`)
+	})
+
+	for _, tc := range testCases {
+		status = tc.status
+		req, _ := suite.client.Get(".")
+		_, err := suite.client.Do(context.Background(), req, nil)
+
+		assert.True(errors.Is(err, tc.sentinel), "status %d", tc.status)
+		assert.True(errors.As(err, tc.target), "status %d", tc.status)
+
+		var errResp *ErrorResponse
+		assert.True(errors.As(err, &errResp))
+		assert.Equal("req-1", errResp.RequestID)
+		assert.Equal("Failure", errResp.Title)
+		assert.Equal("Something failed", errResp.Message)
+	}
+}
+
+func (suite *CalendlyClientTestSuite) TestCheckResponse_validationDetails() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"title":"Invalid Argument","message":"The supplied parameters are invalid.",`+
+			`"details":[{"parameter":"user","message":"must be a valid URI"}]}`)
+	})
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	var validationErr *ValidationError
+	assert.True(errors.As(err, &validationErr))
+	assert.Equal([]*ErrorDetail{{Parameter: "user", Message: "must be a valid URI"}}, validationErr.Details)
+	assert.Contains(err.Error(), "user: must be a valid URI")
+}
+
+func (suite *CalendlyClientTestSuite) TestCheckResponse_otherStatus() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Conflict", http.StatusConflict)
+	})
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	assert.IsType(&ErrorResponse{}, err)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_decodeError() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"email":`)
+	})
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, &Echo{})
+
+	var decodeErr *DecodeError
+	assert.True(errors.As(err, &decodeErr))
+	assert.True(errors.Is(err, ErrDecode))
+	assert.Equal(http.StatusOK, decodeErr.Response.StatusCode)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_networkError() {
+	assert := assert.New(suite.T())
+	suite.server.Close()
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	assert.True(errors.Is(err, ErrNetwork))
+	assert.IsType(&NetworkError{}, err)
+}
@@ -1,28 +1,68 @@
 package calendly
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
-	"bytes"
+	"time"
 )
 
 const (
-	eventTypesPath = "users/me/event_types"
+	eventTypesPath   = "users/me/event_types"
+	eventTypesV2Path = "event_types"
 
 	// Owner event type option
 	IncludeTypeOwner IncludeType = "owner"
+
+	// Event type kinds
+	EventTypeKindSolo  EventTypeKind = "solo"
+	EventTypeKindGroup EventTypeKind = "group"
+
+	// How the hosts of a team event type are pooled
+	PoolingTypeRoundRobin PoolingType = "round_robin"
+	PoolingTypeCollective PoolingType = "collective"
+
+	// Sort orders of event types
+	SortEventTypesNameAsc  = "name:asc"
+	SortEventTypesNameDesc = "name:desc"
 )
 
+// EventTypesAPI is the interface of the event types service, implemented by EventTypesService.
+type EventTypesAPI interface {
+	List(ctx context.Context, opt *EventTypesOpts) ([]*EventType, *Response, error)
+	Iter(opt *EventTypesOpts) *Iterator[*EventType]
+	Get(ctx context.Context, uuidOrURI string) (*EventType, *Response, error)
+}
+
 type EventTypesService apiService
 
 // Include Event type option
 type IncludeType string
 
+// Whether an event type is hosted by one or many invitees at a time
+type EventTypeKind string
+
+// Pooling type of a team event type
+type PoolingType string
 
 type EventTypesOpts struct {
 	// request extra information about the entity that owns the Event Type,
 	// by adding ?include=owner to the URL of the request.
 	Include IncludeType `url:"include,omitempty"`
+
+	// URI of the user or organization whose event types are returned. The event
+	// types of the current user are returned when both are empty. API v2 only.
+	User         string `url:"-"`
+	Organization string `url:"-"`
+
+	// Return only the active or the inactive event types. API v2 only.
+	Active *bool `url:"-"`
+
+	// Order results, e.g. SortEventTypesNameAsc. API v2 only.
+	Sort string `url:"-"`
+
+	ListOptions
 }
 
 type eventTypesResponse struct {
@@ -30,10 +70,10 @@ type eventTypesResponse struct {
 }
 
 type EventType struct {
-	Type string `json:"type"`
-	ID string `json:"id"`
-	Attributes *EventTypeAttributes `json:"attributes,omitempty"`
-	Relationships *Relationships `json:"relationships,omitempty"`
+	Type          string               `json:"type"`
+	ID            string               `json:"id"`
+	Attributes    *EventTypeAttributes `json:"attributes,omitempty"`
+	Relationships *Relationships       `json:"relationships,omitempty"`
 }
 
 type Relationships struct {
@@ -50,18 +90,147 @@ type Data struct {
 }
 
 type EventTypeAttributes struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Duration    int64  `json:"duration"`
-	Slug        string `json:"slug"`
-	Color       string `json:"color"`
-	Active      bool   `json:"active"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
-	URL         string `json:"url"`
-}
-
-func (et *EventType) String() string  {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Duration    time.Duration `json:"duration"`
+	Slug        string        `json:"slug"`
+	Color       string        `json:"color"`
+	Active      bool          `json:"active"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+	URL         string        `json:"url"`
+
+	// The following fields are only returned by API v2
+	Kind            EventTypeKind     `json:"kind,omitempty"`
+	PoolingType     PoolingType       `json:"pooling_type,omitempty"`
+	Secret          bool              `json:"secret,omitempty"`
+	SchedulingURL   string            `json:"scheduling_url,omitempty"`
+	CustomQuestions []*CustomQuestion `json:"custom_questions,omitempty"`
+	Profile         *EventTypeProfile `json:"profile,omitempty"`
+}
+
+// eventTypeAttributesJSON is the wire format of EventTypeAttributes, which carries the
+// duration in minutes.
+type eventTypeAttributesJSON EventTypeAttributes
+
+// A question of the booking form of an event type
+type CustomQuestion struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Position      int      `json:"position"`
+	Enabled       bool     `json:"enabled"`
+	Required      bool     `json:"required"`
+	AnswerChoices []string `json:"answer_choices,omitempty"`
+	IncludeOther  bool     `json:"include_other"`
+}
+
+// The user or team hosting an event type
+type EventTypeProfile struct {
+	// "User" or "Team"
+	Type string `json:"type"`
+	Name string `json:"name"`
+
+	// URI of the owning user or team
+	Owner string `json:"owner"`
+}
+
+// eventTypeV2 is the API v2 representation of an event type.
+type eventTypeV2 struct {
+	URI              string            `json:"uri"`
+	Name             string            `json:"name"`
+	DescriptionPlain string            `json:"description_plain"`
+	Duration         int64             `json:"duration"`
+	Slug             string            `json:"slug"`
+	Color            string            `json:"color"`
+	Active           bool              `json:"active"`
+	Kind             EventTypeKind     `json:"kind"`
+	PoolingType      PoolingType       `json:"pooling_type"`
+	Secret           bool              `json:"secret"`
+	CustomQuestions  []*CustomQuestion `json:"custom_questions"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	SchedulingURL    string            `json:"scheduling_url"`
+	Profile          *EventTypeProfile `json:"profile"`
+}
+
+type eventTypeV2Response struct {
+	Resource *eventTypeV2 `json:"resource"`
+}
+
+type eventTypeV2ListResponse struct {
+	Collection []*eventTypeV2 `json:"collection"`
+	Pagination *Pagination    `json:"pagination"`
+}
+
+type eventTypesV2Opts struct {
+	User         string `url:"user,omitempty"`
+	Organization string `url:"organization,omitempty"`
+	Active       *bool  `url:"active,omitempty"`
+	Sort         string `url:"sort,omitempty"`
+
+	ListOptions
+}
+
+func (r *eventTypeV2ListResponse) pagination() *Pagination {
+	return r.Pagination
+}
+
+func (a *EventTypeAttributes) UnmarshalJSON(data []byte) error {
+	aux := &struct {
+		Duration int64 `json:"duration"`
+		*eventTypeAttributesJSON
+	}{eventTypeAttributesJSON: (*eventTypeAttributesJSON)(a)}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	a.Duration = time.Duration(aux.Duration) * time.Minute
+	return nil
+}
+
+func (a EventTypeAttributes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Duration int64 `json:"duration"`
+		*eventTypeAttributesJSON
+	}{int64(a.Duration / time.Minute), (*eventTypeAttributesJSON)(&a)})
+}
+
+func (e *eventTypeV2) toEventType() *EventType {
+	if e == nil {
+		return nil
+	}
+
+	et := &EventType{
+		Type: "event_types",
+		ID:   e.URI,
+		Attributes: &EventTypeAttributes{
+			Name:            e.Name,
+			Description:     e.DescriptionPlain,
+			Duration:        time.Duration(e.Duration) * time.Minute,
+			Slug:            e.Slug,
+			Color:           e.Color,
+			Active:          e.Active,
+			CreatedAt:       e.CreatedAt,
+			UpdatedAt:       e.UpdatedAt,
+			URL:             e.SchedulingURL,
+			Kind:            e.Kind,
+			PoolingType:     e.PoolingType,
+			Secret:          e.Secret,
+			SchedulingURL:   e.SchedulingURL,
+			CustomQuestions: e.CustomQuestions,
+			Profile:         e.Profile,
+		},
+	}
+	if e.Profile != nil {
+		et.Relationships = &Relationships{
+			Owner: Owner{Data: Data{Type: e.Profile.Type, ID: e.Profile.Owner}},
+		}
+	}
+
+	return et
+}
+
+func (et *EventType) String() string {
 	b := bytes.NewBufferString("")
 	b.WriteString(fmt.Sprintf("EventType: id:%v attributes: ", et.ID))
 	b.WriteString(fmt.Sprintf("Name:%v ", et.Attributes.Name))
@@ -84,8 +253,16 @@ func (et *EventType) String() string  {
 
 // Event Types contain the most important configurations in Calendly.
 // If you need some basic information about your event types, you can use this endpoint.
-func (s *EventTypesService)List(ctx context.Context, opt *EventTypesOpts) ([]*EventType, *Response, error)  {
-	u, err := addUrlOptions(eventTypesPath	, opt)
+//
+// With API v2 the event types of the user or organization of the options are listed, those
+// of the current user by default. The owner profile is always included and the ID of each
+// event type is its resource URI.
+func (s *EventTypesService) List(ctx context.Context, opt *EventTypesOpts) ([]*EventType, *Response, error) {
+	if s.client.version == APIV2 {
+		return s.listV2(ctx, opt)
+	}
+
+	u, err := addUrlOptions(eventTypesPath, opt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -102,4 +279,84 @@ func (s *EventTypesService)List(ctx context.Context, opt *EventTypesOpts) ([]*Ev
 	}
 
 	return et.Data, resp, nil
-}
\ No newline at end of file
+}
+
+// Iter returns an Iterator over all the event types. With API v1 there is a single page.
+func (s *EventTypesService) Iter(opt *EventTypesOpts) *Iterator[*EventType] {
+	o := EventTypesOpts{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*EventType, *Response, error) {
+		o.PageToken = pageToken
+		return s.List(ctx, &o)
+	})
+}
+
+func (s *EventTypesService) listV2(ctx context.Context, opt *EventTypesOpts) ([]*EventType, *Response, error) {
+	v2Opts := &eventTypesV2Opts{}
+	if opt != nil {
+		v2Opts = &eventTypesV2Opts{
+			User:         opt.User,
+			Organization: opt.Organization,
+			Active:       opt.Active,
+			Sort:         opt.Sort,
+			ListOptions:  opt.ListOptions,
+		}
+	}
+
+	if v2Opts.User == "" && v2Opts.Organization == "" {
+		me, resp, err := s.client.Users.AboutMe(ctx)
+		if err != nil {
+			return nil, resp, err
+		}
+		if me == nil {
+			return nil, resp, ErrNoUser
+		}
+		v2Opts.User = me.ID
+	}
+
+	u, err := addUrlOptions(eventTypesV2Path, v2Opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	et := &eventTypeV2ListResponse{}
+	resp, err := s.client.Do(ctx, req, et)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	eventTypes := make([]*EventType, 0, len(et.Collection))
+	for _, e := range et.Collection {
+		eventTypes = append(eventTypes, e.toEventType())
+	}
+
+	return eventTypes, resp, nil
+}
+
+// Get returns a single event type by its UUID or URI. API v2 only.
+func (s *EventTypesService) Get(ctx context.Context, uuidOrURI string) (*EventType, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(eventTypesV2Path, uuidOrURI))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	et := &eventTypeV2Response{}
+	resp, err := s.client.Do(ctx, req, et)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return et.Resource.toEventType(), resp, nil
+}
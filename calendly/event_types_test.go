@@ -1,10 +1,12 @@
 package calendly
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
+	"encoding/json"
 	"fmt"
+	"github.com/stretchr/testify/assert"
 	"net/http"
-	"context"
+	"time"
 )
 
 func (suite *CalendlyClientTestSuite) TestEventTypesService_ListEventTypes() {
@@ -25,3 +27,110 @@ func (suite *CalendlyClientTestSuite) TestEventTypesService_ListEventTypes() {
 	}
 	assert.Equal(want, eventTypes)
 }
+
+func (suite *CalendlyClientTestSuite) TestEventTypesService_ListEventTypesV2() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	suite.handleAboutMeV2()
+
+	suite.mux.HandleFunc("/"+eventTypesV2Path, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		assert.Equal("https://api.calendly.com/users/U1", r.URL.Query().Get("user"))
+		fmt.Fprint(w, `{"collection":[{"uri":"https://api.calendly.com/event_types/E1","duration":30,`+
+			`"profile":{"type":"User","owner":"https://api.calendly.com/users/U1"}}],"pagination":{"count":1}}`)
+	})
+
+	eventTypes, _, err := suite.client.EventTypes.List(context.Background(), nil)
+	assert.Nil(err)
+
+	want := []*EventType{
+		{
+			Type: "event_types",
+			ID:   "https://api.calendly.com/event_types/E1",
+			Attributes: &EventTypeAttributes{
+				Duration: 30 * time.Minute,
+				Profile:  &EventTypeProfile{Type: "User", Owner: "https://api.calendly.com/users/U1"},
+			},
+			Relationships: &Relationships{Owner: Owner{Data: Data{Type: "User", ID: "https://api.calendly.com/users/U1"}}},
+		},
+	}
+	assert.Equal(want, eventTypes)
+}
+
+func (suite *CalendlyClientTestSuite) TestEventTypesService_ListEventTypesV2Organization() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+eventTypesV2Path, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Empty(q.Get("user"))
+		assert.Equal("https://api.calendly.com/organizations/O1", q.Get("organization"))
+		assert.Equal("false", q.Get("active"))
+		assert.Equal(SortEventTypesNameAsc, q.Get("sort"))
+		fmt.Fprint(w, `{"collection":[{"uri":"E1","kind":"group","pooling_type":"round_robin","secret":true,`+
+			`"custom_questions":[{"name":"Phone","type":"phone_number","position":1,"enabled":true,"required":true}]}],`+
+			`"pagination":{"count":1}}`)
+	})
+
+	active := false
+	eventTypes, _, err := suite.client.EventTypes.List(context.Background(), &EventTypesOpts{
+		Organization: "https://api.calendly.com/organizations/O1",
+		Active:       &active,
+		Sort:         SortEventTypesNameAsc,
+	})
+	assert.Nil(err)
+
+	attrs := eventTypes[0].Attributes
+	assert.Equal(EventTypeKindGroup, attrs.Kind)
+	assert.Equal(PoolingTypeRoundRobin, attrs.PoolingType)
+	assert.True(attrs.Secret)
+	assert.Equal([]*CustomQuestion{{Name: "Phone", Type: "phone_number", Position: 1, Enabled: true, Required: true}},
+		attrs.CustomQuestions)
+}
+
+func (suite *CalendlyClientTestSuite) TestEventTypesService_Get() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+eventTypesV2Path+"/E1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/event_types/E1","name":"Intro","duration":45,`+
+			`"kind":"solo","scheduling_url":"https://calendly.com/me/intro",`+
+			`"created_at":"2020-01-02T03:04:05.678Z","updated_at":"2020-02-03T04:05:06Z"}}`)
+	})
+
+	eventType, _, err := suite.client.EventTypes.Get(context.Background(), "https://api.calendly.com/event_types/E1")
+	assert.Nil(err)
+
+	want := &EventType{
+		Type: "event_types",
+		ID:   "https://api.calendly.com/event_types/E1",
+		Attributes: &EventTypeAttributes{
+			Name:          "Intro",
+			Duration:      45 * time.Minute,
+			URL:           "https://calendly.com/me/intro",
+			SchedulingURL: "https://calendly.com/me/intro",
+			Kind:          EventTypeKindSolo,
+			CreatedAt:     time.Date(2020, 1, 2, 3, 4, 5, 678000000, time.UTC),
+			UpdatedAt:     time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC),
+		},
+	}
+	assert.Equal(want, eventType)
+
+	suite.client = NewClient(nil)
+	_, _, err = suite.client.EventTypes.Get(context.Background(), "E1")
+	assert.Equal(errUnsupportedVersion, err)
+}
+
+func (suite *CalendlyClientTestSuite) TestEventTypeAttributes_Duration() {
+	assert := assert.New(suite.T())
+
+	attrs := &EventTypeAttributes{}
+	assert.Nil(json.Unmarshal([]byte(`{"name":"Intro","duration":15}`), attrs))
+	assert.Equal(15*time.Minute, attrs.Duration)
+	assert.Equal("Intro", attrs.Name)
+
+	data, err := json.Marshal(attrs)
+	assert.Nil(err)
+	assert.Contains(string(data), `"duration":15`)
+}
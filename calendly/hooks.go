@@ -0,0 +1,149 @@
+package calendly
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Hooks are called by Client.Do around every request, e.g. to log or trace them.
+// Any of them can be nil. They are called synchronously, so they should return quickly.
+type Hooks struct {
+	// BeforeRequest is called before the request is sent or served from the cache
+	BeforeRequest func(ctx context.Context, req *http.Request)
+
+	// AfterResponse is called when Do succeeds, with the time it took
+	AfterResponse func(ctx context.Context, req *http.Request, resp *Response, latency time.Duration)
+
+	// OnError is called when Do fails. The response is nil when none was received,
+	// e.g. after a network error.
+	OnError func(ctx context.Context, req *http.Request, resp *Response, err error, latency time.Duration)
+}
+
+// WithHooks is a client option adding hooks called around every request. Hooks added by
+// several options are called in the order the options are given.
+func WithHooks(h Hooks) ClientOpt {
+	return func(c *Client) {
+		c.hooks = append(c.hooks, h)
+	}
+}
+
+// WithLogger is a client option logging every request with the given logger. Successful
+// requests are logged at the debug level and failed ones at the error level, with their method,
+// path, status, latency, request ID and number of retries. Credentials never reach the logger:
+// headers are left out and the values of query parameters holding secrets are redacted.
+func WithLogger(logger *slog.Logger) ClientOpt {
+	return WithHooks(LogHooks(logger))
+}
+
+// LogHooks returns the hooks used by WithLogger, to be combined with others.
+func LogHooks(logger *slog.Logger) Hooks {
+	return Hooks{
+		AfterResponse: func(ctx context.Context, req *http.Request, resp *Response, latency time.Duration) {
+			logger.LogAttrs(ctx, slog.LevelDebug, "calendly request", requestAttrs(req, resp, nil, latency)...)
+		},
+		OnError: func(ctx context.Context, req *http.Request, resp *Response, err error, latency time.Duration) {
+			logger.LogAttrs(ctx, slog.LevelError, "calendly request failed", requestAttrs(req, resp, err, latency)...)
+		},
+	}
+}
+
+// doWithHooks calls do surrounded by the hooks of the client.
+func (c *Client) doWithHooks(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	for _, h := range c.hooks {
+		if h.BeforeRequest != nil {
+			h.BeforeRequest(ctx, req)
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.do(ctx, req, v)
+	latency := time.Since(start)
+
+	for _, h := range c.hooks {
+		if err == nil && h.AfterResponse != nil {
+			h.AfterResponse(ctx, req, resp, latency)
+		}
+		if err != nil && h.OnError != nil {
+			h.OnError(ctx, req, resp, err, latency)
+		}
+	}
+
+	return resp, err
+}
+
+// Query parameters whose values are redacted from the logs
+var secretParams = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"token":         true,
+	"client_secret": true,
+	"code":          true,
+	"api_key":       true,
+}
+
+func requestAttrs(req *http.Request, resp *Response, err error, latency time.Duration) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+	}
+	query := redactQuery(req.URL.Query())
+	if query != "" {
+		attrs = append(attrs, slog.String("query", query))
+	}
+	attrs = append(attrs, slog.Duration("latency", latency))
+
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode), slog.Int("retries", resp.Retries))
+		if resp.Cached {
+			attrs = append(attrs, slog.Bool("cached", true))
+		}
+	}
+	var netErr *NetworkError
+	if resp == nil && errors.As(err, &netErr) {
+		attrs = append(attrs, slog.Int("retries", netErr.Retries))
+	}
+
+	if id := requestID(resp, err); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	if err != nil {
+		// Errors quote the URL of the request, secrets included
+		msg := err.Error()
+		if req.URL.RawQuery != "" {
+			msg = strings.ReplaceAll(msg, req.URL.RawQuery, query)
+		}
+		attrs = append(attrs, slog.String("error", msg))
+	}
+
+	return attrs
+}
+
+// requestID returns the ID Calendly gave the request, from the error body or the headers.
+func requestID(resp *Response, err error) string {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.RequestID != "" {
+		return errResp.RequestID
+	}
+	if resp != nil && resp.Response != nil {
+		return resp.Header.Get(headerRequestID)
+	}
+
+	return ""
+}
+
+func redactQuery(q url.Values) string {
+	for name, values := range q {
+		if secretParams[strings.ToLower(name)] {
+			for i := range values {
+				values[i] = "REDACTED"
+			}
+		}
+	}
+
+	return q.Encode()
+}
@@ -0,0 +1,135 @@
+package calendly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestDo_hooks() {
+	assert := assert.New(suite.T())
+
+	var calls []string
+	WithHooks(Hooks{
+		BeforeRequest: func(ctx context.Context, req *http.Request) {
+			calls = append(calls, "before "+req.URL.Path)
+		},
+		AfterResponse: func(ctx context.Context, req *http.Request, resp *Response, latency time.Duration) {
+			calls = append(calls, fmt.Sprintf("after %d", resp.StatusCode))
+		},
+		OnError: func(ctx context.Context, req *http.Request, resp *Response, err error, latency time.Duration) {
+			calls = append(calls, fmt.Sprintf("error %d", resp.StatusCode))
+		},
+	})(suite.client)
+	WithHooks(Hooks{
+		AfterResponse: func(ctx context.Context, req *http.Request, resp *Response, latency time.Duration) {
+			calls = append(calls, "second after")
+		},
+	})(suite.client)
+
+	suite.mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+	suite.mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Resource Not Found"}`)
+	})
+
+	req, _ := suite.client.Get("ok")
+	_, err := suite.client.Do(context.Background(), req, nil)
+	assert.Nil(err)
+
+	req, _ = suite.client.Get("missing")
+	_, err = suite.client.Do(context.Background(), req, nil)
+	assert.Error(err)
+
+	assert.Equal([]string{
+		"before /ok", "after 200", "second after",
+		"before /missing", "error 404",
+	}, calls)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_logger() {
+	assert := assert.New(suite.T())
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	WithLogger(logger)(suite.client)
+	WithRetryPolicy(RetryPolicy{MaxAttempts: 2})(suite.client)
+
+	attempts := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set(headerRequestID, "req-1")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"Unauthenticated","request_id":"req-2"}`)
+	})
+
+	req, _ := suite.client.Get("users?access_token=secret&count=10")
+	_, err := suite.client.Do(context.Background(), req, nil)
+	assert.Error(err)
+
+	assert.NotContains(buf.String(), "secret")
+
+	record := map[string]interface{}{}
+	assert.Nil(json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal("ERROR", record["level"])
+	assert.Equal("GET", record["method"])
+	assert.Equal("/users", record["path"])
+	assert.Equal("access_token=REDACTED&count=10", record["query"])
+	assert.Equal(float64(http.StatusUnauthorized), record["status"])
+	assert.Equal(float64(1), record["retries"])
+	assert.Equal("req-2", record["request_id"])
+	assert.Contains(record["error"], "Unauthenticated")
+	assert.Contains(record, "latency")
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_loggerNetworkError() {
+	assert := assert.New(suite.T())
+
+	buf := new(bytes.Buffer)
+	WithLogger(slog.New(slog.NewJSONHandler(buf, nil)))(suite.client)
+	WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond})(suite.client)
+	suite.server.Close()
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	var netErr *NetworkError
+	assert.True(errors.As(err, &netErr))
+	assert.Equal(2, netErr.Retries)
+
+	record := map[string]interface{}{}
+	assert.Nil(json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal("ERROR", record["level"])
+	assert.Equal(float64(2), record["retries"])
+	assert.NotContains(record, "status")
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_loggerDebug() {
+	assert := assert.New(suite.T())
+
+	buf := new(bytes.Buffer)
+	WithLogger(slog.New(slog.NewTextHandler(buf, nil)))(suite.client)
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	assert.Nil(err)
+	assert.Empty(buf.String())
+}
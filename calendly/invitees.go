@@ -0,0 +1,199 @@
+package calendly
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	inviteesPath     = "invitees"
+	cancellationPath = "cancellation"
+
+	// Invitee statuses
+	InviteeStatusActive   InviteeStatus = "active"
+	InviteeStatusCanceled InviteeStatus = "canceled"
+)
+
+// InviteesAPI is the interface of the invitees service, implemented by InviteesService.
+type InviteesAPI interface {
+	List(ctx context.Context, event string, opt *InviteesOpts) ([]*Invitee, *Response, error)
+	Iter(event string, opt *InviteesOpts) *Iterator[*Invitee]
+	Get(ctx context.Context, event, invitee string) (*Invitee, *Response, error)
+	CancelEvent(ctx context.Context, event, reason string) (*Cancellation, *Response, error)
+}
+
+// InviteesService gives access to the people who booked a scheduled event. API v2 only.
+type InviteesService apiService
+
+// Status of an invitee
+type InviteeStatus string
+
+type InviteesOpts struct {
+	// Whether the invitee is active or canceled
+	Status InviteeStatus `url:"status,omitempty"`
+
+	// Return invitees with this email address
+	Email string `url:"email,omitempty"`
+
+	// Order results by the creation time, e.g. "created_at:asc"
+	Sort string `url:"sort,omitempty"`
+
+	ListOptions
+}
+
+type Invitee struct {
+	URI                 string               `json:"uri"`
+	Email               string               `json:"email"`
+	Name                string               `json:"name"`
+	FirstName           string               `json:"first_name,omitempty"`
+	LastName            string               `json:"last_name,omitempty"`
+	Status              InviteeStatus        `json:"status"`
+	Timezone            string               `json:"timezone"`
+	Event               string               `json:"event"`
+	QuestionsAndAnswers []*QuestionAndAnswer `json:"questions_and_answers,omitempty"`
+	Tracking            *Tracking            `json:"tracking,omitempty"`
+	TextReminderNumber  string               `json:"text_reminder_number,omitempty"`
+	Rescheduled         bool                 `json:"rescheduled"`
+	OldInvitee          string               `json:"old_invitee,omitempty"`
+	NewInvitee          string               `json:"new_invitee,omitempty"`
+	CancelURL           string               `json:"cancel_url"`
+	RescheduleURL       string               `json:"reschedule_url"`
+	Cancellation        *Cancellation        `json:"cancellation,omitempty"`
+	CreatedAt           time.Time            `json:"created_at"`
+	UpdatedAt           time.Time            `json:"updated_at"`
+}
+
+// An answer given by the invitee to a question of the booking form
+type QuestionAndAnswer struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+	Position int    `json:"position"`
+}
+
+// UTM and Salesforce parameters the invitee booked with
+type Tracking struct {
+	UTMCampaign    string `json:"utm_campaign,omitempty"`
+	UTMSource      string `json:"utm_source,omitempty"`
+	UTMMedium      string `json:"utm_medium,omitempty"`
+	UTMContent     string `json:"utm_content,omitempty"`
+	UTMTerm        string `json:"utm_term,omitempty"`
+	SalesforceUUID string `json:"salesforce_uuid,omitempty"`
+}
+
+type inviteeResponse struct {
+	Resource *Invitee `json:"resource"`
+}
+
+type inviteeListResponse struct {
+	Collection []*Invitee  `json:"collection"`
+	Pagination *Pagination `json:"pagination"`
+}
+
+type cancellationRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type cancellationResponse struct {
+	Resource *Cancellation `json:"resource"`
+}
+
+func (r *inviteeListResponse) pagination() *Pagination {
+	return r.Pagination
+}
+
+func (i *Invitee) String() string {
+	b := bytes.NewBufferString("")
+	b.WriteString(fmt.Sprintf("Invitee: uri:%v ", i.URI))
+	b.WriteString(fmt.Sprintf("Name:%v ", i.Name))
+	b.WriteString(fmt.Sprintf("Email:%v ", i.Email))
+	b.WriteString(fmt.Sprintf("Status:%v ", i.Status))
+	b.WriteString(fmt.Sprintf("Event:%v", i.Event))
+
+	return b.String()
+}
+
+// List returns the invitees of the scheduled event identified by its UUID or URI.
+func (s *InviteesService) List(ctx context.Context, event string, opt *InviteesOpts) ([]*Invitee, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	u, err := addUrlOptions(eventInviteesPath(event), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inv := &inviteeListResponse{}
+	resp, err := s.client.Do(ctx, req, inv)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return inv.Collection, resp, nil
+}
+
+// Iter returns an Iterator over all the invitees of the scheduled event matching the options.
+func (s *InviteesService) Iter(event string, opt *InviteesOpts) *Iterator[*Invitee] {
+	o := InviteesOpts{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*Invitee, *Response, error) {
+		o.PageToken = pageToken
+		return s.List(ctx, event, &o)
+	})
+}
+
+// Get returns a single invitee of a scheduled event. The invitee may be given as its UUID,
+// in which case event has to identify the scheduled event, or as its full URI.
+func (s *InviteesService) Get(ctx context.Context, event, invitee string) (*Invitee, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(eventInviteesPath(event), invitee))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inv := &inviteeResponse{}
+	resp, err := s.client.Do(ctx, req, inv)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return inv.Resource, resp, nil
+}
+
+// CancelEvent cancels the scheduled event identified by its UUID or URI, notifying
+// its invitees with the given reason.
+func (s *InviteesService) CancelEvent(ctx context.Context, event, reason string) (*Cancellation, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Post(resourcePath(scheduledEventsPath, event)+"/"+cancellationPath, &cancellationRequest{Reason: reason})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &cancellationResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c.Resource, resp, nil
+}
+
+func eventInviteesPath(event string) string {
+	return resourcePath(scheduledEventsPath, event) + "/" + inviteesPath
+}
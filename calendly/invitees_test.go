@@ -0,0 +1,91 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestInviteesService_List() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s/E1/%s", scheduledEventsPath, inviteesPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		assert.Equal("canceled", r.URL.Query().Get("status"))
+		assert.Equal("a@example.com", r.URL.Query().Get("email"))
+		fmt.Fprint(w, `{"collection":[{"uri":"I1"},{"uri":"I2"}],"pagination":{"count":2}}`)
+	})
+
+	invitees, _, err := suite.client.Invitees.List(context.Background(), "https://api.calendly.com/scheduled_events/E1",
+		&InviteesOpts{Status: InviteeStatusCanceled, Email: "a@example.com"})
+	assert.Nil(err)
+
+	want := []*Invitee{
+		{URI: "I1"},
+		{URI: "I2"},
+	}
+	assert.Equal(want, invitees)
+}
+
+func (suite *CalendlyClientTestSuite) TestInviteesService_Get() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s/E1/%s/I1", scheduledEventsPath, inviteesPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		fmt.Fprint(w, `{"resource":{"uri":"I1","questions_and_answers":[{"question":"Q","answer":"A","position":0}],`+
+			`"tracking":{"utm_source":"newsletter"},"cancel_url":"https://cancel","reschedule_url":"https://reschedule"}}`)
+	})
+
+	invitee, _, err := suite.client.Invitees.Get(context.Background(), "E1", "I1")
+	assert.Nil(err)
+
+	want := &Invitee{
+		URI:                 "I1",
+		QuestionsAndAnswers: []*QuestionAndAnswer{{Question: "Q", Answer: "A"}},
+		Tracking:            &Tracking{UTMSource: "newsletter"},
+		CancelURL:           "https://cancel",
+		RescheduleURL:       "https://reschedule",
+	}
+	assert.Equal(want, invitee)
+}
+
+func (suite *CalendlyClientTestSuite) TestInviteesService_CancelEvent() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s/E1/%s", scheduledEventsPath, cancellationPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodPost)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(`{"reason":"Out of office"}`+"\n", string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resource":{"canceled_by":"Me","reason":"Out of office","canceler_type":"host"}}`)
+	})
+
+	c, _, err := suite.client.Invitees.CancelEvent(context.Background(), "E1", "Out of office")
+	assert.Nil(err)
+
+	want := &Cancellation{CanceledBy: "Me", Reason: "Out of office", CancelerType: "host"}
+	assert.Equal(want, c)
+}
+
+func (suite *CalendlyClientTestSuite) TestInviteesService_V1Unsupported() {
+	assert := assert.New(suite.T())
+
+	_, _, err := suite.client.Invitees.List(context.Background(), "E1", nil)
+	assert.Equal(errUnsupportedVersion, err)
+
+	_, _, err = suite.client.Invitees.Get(context.Background(), "E1", "I1")
+	assert.Equal(errUnsupportedVersion, err)
+
+	_, _, err = suite.client.Invitees.CancelEvent(context.Background(), "E1", "reason")
+	assert.Equal(errUnsupportedVersion, err)
+}
@@ -0,0 +1,282 @@
+package calendly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context/ctxhttp"
+)
+
+const (
+	defaultAuthBaseURL = "https://auth.calendly.com/"
+	oauthAuthorizePath = "oauth/authorize"
+	oauthTokenPath     = "oauth/token"
+	oauthRevokePath    = "oauth/revoke"
+
+	// Access tokens are refreshed this long before they expire
+	tokenExpiryDelta = 10 * time.Second
+)
+
+var errNoToken = errors.New("go-calendly: no OAuth token available, complete the authorization flow first")
+
+// OAuthConfig describes a Calendly OAuth 2.0 application using the authorization code flow.
+type OAuthConfig struct {
+	// Client ID and secret of the OAuth application
+	ClientID     string
+	ClientSecret string
+
+	// Redirect URI registered for the application
+	RedirectURL string
+
+	// Base URL of the authorization server, https://auth.calendly.com/ when empty
+	AuthBaseURL string
+
+	// HTTP client used for the token requests, http.DefaultClient when nil
+	HTTPClient *http.Client
+}
+
+// OAuthError is returned by the token endpoints when a grant is rejected.
+type OAuthError struct {
+	// HTTP response that caused this error
+	Response *http.Response
+
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *OAuthError) Error() string {
+	return fmt.Sprintf("go-calendly: oauth %v: %d %v %v",
+		e.Response.Request.URL, e.Response.StatusCode, e.Code, e.Description)
+}
+
+// TokenStore persists the token of an OAuth client, so that refreshed tokens survive restarts.
+// Implementations must be safe for concurrent use.
+type TokenStore interface {
+	// Load returns the stored token, or nil when there is none.
+	Load(ctx context.Context) (*Token, error)
+
+	// Save stores a newly issued token.
+	Save(ctx context.Context, t *Token) error
+}
+
+// MemoryTokenStore is a TokenStore keeping the token in memory.
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewMemoryTokenStore returns a MemoryTokenStore holding the given token, which may be nil.
+func NewMemoryTokenStore(t *Token) *MemoryTokenStore {
+	return &MemoryTokenStore{token: t}
+}
+
+func (s *MemoryTokenStore) Load(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, nil
+}
+
+func (s *MemoryTokenStore) Save(ctx context.Context, t *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = t
+	return nil
+}
+
+// AuthCodeURL returns the URL of the consent page the user has to be redirected to.
+// state is echoed back to the redirect URL and should be checked to prevent CSRF.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+	v := url.Values{
+		"client_id":     {c.ClientID},
+		"response_type": {"code"},
+		"redirect_uri":  {c.RedirectURL},
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	return c.endpoint(oauthAuthorizePath) + "?" + v.Encode()
+}
+
+// Exchange converts the authorization code received on the redirect URL into a token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.retrieveToken(ctx, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.RedirectURL},
+	})
+}
+
+// Refresh issues a new token from a refresh token.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	return c.retrieveToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+// Revoke invalidates an access or refresh token.
+func (c *OAuthConfig) Revoke(ctx context.Context, token string) error {
+	resp, err := c.postForm(ctx, oauthRevokePath, url.Values{"token": {token}})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// TokenSource returns a TokenSource supplying the access token kept in store. The token
+// is refreshed when it expires and the refreshed token is saved back to the store.
+// Concurrent callers share a single refresh.
+func (c *OAuthConfig) TokenSource(store TokenStore) TokenSource {
+	return &oauthTokenSource{config: c, store: store}
+}
+
+// NewOAuthClient returns a new http Client which signs requests with the access token kept in
+// store, refreshing it when it expires and saving the refreshed token back to the store.
+// Use it with NewClient and WithAPIVersion(APIV2).
+func NewOAuthClient(config *OAuthConfig, store TokenStore) *http.Client {
+	return NewAuthClient(config.TokenSource(store))
+}
+
+type oauthTokenSource struct {
+	config *OAuthConfig
+	store  TokenStore
+
+	mu sync.Mutex
+}
+
+// Token returns the stored token, refreshing it first when it expired.
+func (s *oauthTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, err := s.store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, errNoToken
+	}
+	if token.Valid() {
+		return token, nil
+	}
+	if token.RefreshToken == "" {
+		return nil, errors.New("go-calendly: OAuth token expired and cannot be refreshed")
+	}
+
+	refreshed, err := s.config.Refresh(ctx, token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	// The token endpoint may leave out the fields that did not change
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+	if refreshed.Scope == "" {
+		refreshed.Scope = token.Scope
+	}
+	if refreshed.Owner == "" {
+		refreshed.Owner = token.Owner
+	}
+	if refreshed.Organization == "" {
+		refreshed.Organization = token.Organization
+	}
+	token = refreshed
+
+	if err := s.store.Save(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// tokenResponse is the token endpoint response, which reports the lifetime of the token
+// relative to its creation.
+type tokenResponse struct {
+	Token
+	ExpiresIn int64 `json:"expires_in"`
+	CreatedAt int64 `json:"created_at"`
+}
+
+func (c *OAuthConfig) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	resp, err := c.postForm(ctx, oauthTokenPath, v)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	tr := &tokenResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(tr); err != nil {
+		return nil, &DecodeError{Response: resp, Err: err}
+	}
+
+	token := tr.Token
+	if tr.ExpiresIn > 0 {
+		created := time.Now()
+		if tr.CreatedAt > 0 {
+			created = time.Unix(tr.CreatedAt, 0)
+		}
+		token.Expiry = created.Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+
+	return &token, nil
+}
+
+// postForm posts the client credentials and the given values to an endpoint of the
+// authorization server, turning error responses into an *OAuthError.
+func (c *OAuthConfig) postForm(ctx context.Context, path string, v url.Values) (*http.Response, error) {
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+
+	req, err := http.NewRequest(http.MethodPost, c.endpoint(path), strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", mediaType)
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := ctxhttp.Do(ctx, httpClient, req)
+	if err != nil {
+		return nil, &NetworkError{Err: err}
+	}
+
+	if c := resp.StatusCode; c < 200 || c > 299 {
+		defer resp.Body.Close()
+
+		oauthErr := &OAuthError{Response: resp}
+		data, _ := ioutil.ReadAll(resp.Body)
+		if json.Unmarshal(data, oauthErr) != nil || oauthErr.Code == "" {
+			oauthErr.Description = string(data)
+		}
+
+		return nil, oauthErr
+	}
+
+	return resp, nil
+}
+
+func (c *OAuthConfig) endpoint(path string) string {
+	base := c.AuthBaseURL
+	if base == "" {
+		base = defaultAuthBaseURL
+	}
+
+	return strings.TrimSuffix(base, "/") + "/" + path
+}
@@ -0,0 +1,176 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) oauthConfig() *OAuthConfig {
+	return &OAuthConfig{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		AuthBaseURL:  suite.server.URL,
+	}
+}
+
+func (suite *CalendlyClientTestSuite) TestOAuthConfig_AuthCodeURL() {
+	assert := assert.New(suite.T())
+
+	u, err := url.Parse(suite.oauthConfig().AuthCodeURL("xyz"))
+	assert.Nil(err)
+	assert.Equal("/"+oauthAuthorizePath, u.Path)
+	assert.Equal(url.Values{
+		"client_id":     {"client"},
+		"response_type": {"code"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"state":         {"xyz"},
+	}, u.Query())
+}
+
+func (suite *CalendlyClientTestSuite) TestOAuthConfig_Exchange() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/"+oauthTokenPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodPost, r.Method)
+		r.ParseForm()
+		assert.Equal("authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal("code", r.PostForm.Get("code"))
+		assert.Equal("client", r.PostForm.Get("client_id"))
+		assert.Equal("secret", r.PostForm.Get("client_secret"))
+		fmt.Fprint(w, `{"token_type":"Bearer","access_token":"access","refresh_token":"refresh",`+
+			`"expires_in":7200,"created_at":1548689183,"owner":"https://api.calendly.com/users/U1"}`)
+	})
+
+	token, err := suite.oauthConfig().Exchange(context.Background(), "code")
+	assert.Nil(err)
+	assert.Equal(&Token{
+		AccessToken:  "access",
+		TokenType:    "Bearer",
+		RefreshToken: "refresh",
+		Owner:        "https://api.calendly.com/users/U1",
+		Expiry:       time.Unix(1548689183+7200, 0),
+	}, token)
+}
+
+func (suite *CalendlyClientTestSuite) TestOAuthConfig_ExchangeError() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/"+oauthTokenPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"invalid_grant","error_description":"The code is invalid"}`)
+	})
+
+	_, err := suite.oauthConfig().Exchange(context.Background(), "bad")
+	oauthErr, ok := err.(*OAuthError)
+	assert.True(ok)
+	assert.Equal("invalid_grant", oauthErr.Code)
+	assert.Equal("The code is invalid", oauthErr.Description)
+}
+
+func (suite *CalendlyClientTestSuite) TestOAuthConfig_Revoke() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/"+oauthRevokePath, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		assert.Equal("access", r.PostForm.Get("token"))
+		fmt.Fprint(w, `{}`)
+	})
+
+	assert.Nil(suite.oauthConfig().Revoke(context.Background(), "access"))
+}
+
+func (suite *CalendlyClientTestSuite) TestOAuthClient_Refresh() {
+	assert := assert.New(suite.T())
+
+	refreshes := 0
+	suite.mux.HandleFunc("/"+oauthTokenPath, func(w http.ResponseWriter, r *http.Request) {
+		refreshes++
+		r.ParseForm()
+		assert.Equal("refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal("old-refresh", r.PostForm.Get("refresh_token"))
+		fmt.Fprint(w, `{"access_token":"new-access","refresh_token":"new-refresh","expires_in":7200}`)
+	})
+	suite.mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Bearer new-access", r.Header.Get(BearerHeaderTokenKey))
+		fmt.Fprint(w, `{"email":"echo@echo.com"}`)
+	})
+
+	store := NewMemoryTokenStore(&Token{
+		AccessToken:  "old-access",
+		RefreshToken: "old-refresh",
+		Expiry:       time.Now().Add(-time.Minute),
+	})
+	client := NewClient(NewOAuthClient(suite.oauthConfig(), store))
+	client.BaseURL = suite.client.BaseURL
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Echo(context.Background())
+			assert.Nil(err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(1, refreshes)
+	token, _ := store.Load(context.Background())
+	assert.Equal("new-refresh", token.RefreshToken)
+	assert.True(token.Valid())
+}
+
+func (suite *CalendlyClientTestSuite) TestOAuthClient_RefreshKeepsFields() {
+	assert := assert.New(suite.T())
+
+	refreshes := 0
+	suite.mux.HandleFunc("/"+oauthTokenPath, func(w http.ResponseWriter, r *http.Request) {
+		refreshes++
+		r.ParseForm()
+		assert.Equal("old-refresh", r.PostForm.Get("refresh_token"))
+		fmt.Fprint(w, `{"access_token":"new-access","expires_in":60,"created_at":1}`)
+	})
+	suite.mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"email":"echo@echo.com"}`)
+	})
+
+	store := NewMemoryTokenStore(&Token{
+		AccessToken:  "old-access",
+		RefreshToken: "old-refresh",
+		Owner:        "https://api.calendly.com/users/U1",
+		Organization: "https://api.calendly.com/organizations/O1",
+		Expiry:       time.Now().Add(-time.Minute),
+	})
+	client := NewClient(NewOAuthClient(suite.oauthConfig(), store))
+	client.BaseURL = suite.client.BaseURL
+
+	// the refreshed token expires at once, so the second call refreshes it again
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Echo(context.Background())
+		assert.Nil(err)
+	}
+
+	assert.Equal(2, refreshes)
+	token, _ := store.Load(context.Background())
+	assert.Equal("new-access", token.AccessToken)
+	assert.Equal("old-refresh", token.RefreshToken)
+	assert.Equal("https://api.calendly.com/users/U1", token.Owner)
+	assert.Equal("https://api.calendly.com/organizations/O1", token.Organization)
+}
+
+func (suite *CalendlyClientTestSuite) TestOAuthClient_NoToken() {
+	assert := assert.New(suite.T())
+
+	client := NewClient(NewOAuthClient(suite.oauthConfig(), NewMemoryTokenStore(nil)))
+	client.BaseURL = suite.client.BaseURL
+
+	_, _, err := client.Echo(context.Background())
+	assert.NotNil(err)
+}
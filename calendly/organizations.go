@@ -0,0 +1,353 @@
+package calendly
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	organizationsPath           = "organizations"
+	organizationMembershipsPath = "organization_memberships"
+	invitationsPath             = "invitations"
+
+	// Roles of the members of an organization
+	MembershipRoleOwner MembershipRole = "owner"
+	MembershipRoleAdmin MembershipRole = "admin"
+	MembershipRoleUser  MembershipRole = "user"
+
+	// Invitation statuses
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+)
+
+// OrganizationsAPI is the interface of the organizations service, implemented by OrganizationsService.
+type OrganizationsAPI interface {
+	ListMemberships(ctx context.Context, opt *MembershipsOpts) ([]*OrganizationMembership, *Response, error)
+	IterMemberships(opt *MembershipsOpts) *Iterator[*OrganizationMembership]
+	GetMembership(ctx context.Context, uuidOrURI string) (*OrganizationMembership, *Response, error)
+	RemoveMembership(ctx context.Context, uuidOrURI string) (*Response, error)
+	ListInvitations(ctx context.Context, organization string, opt *InvitationsOpts) ([]*OrganizationInvitation, *Response, error)
+	IterInvitations(organization string, opt *InvitationsOpts) *Iterator[*OrganizationInvitation]
+	GetInvitation(ctx context.Context, organization, invitation string) (*OrganizationInvitation, *Response, error)
+	Invite(ctx context.Context, organization, email string) (*OrganizationInvitation, *Response, error)
+	RevokeInvitation(ctx context.Context, organization, invitation string) (*Response, error)
+}
+
+// OrganizationsService manages the members of an organization and the invitations
+// to join it. API v2 only.
+type OrganizationsService apiService
+
+// Role of a user within an organization
+type MembershipRole string
+
+// Status of an organization invitation
+type InvitationStatus string
+
+type MembershipsOpts struct {
+	// URI of the organization whose memberships are returned
+	Organization string `url:"organization,omitempty"`
+
+	// URI of the user whose memberships are returned
+	User string `url:"user,omitempty"`
+
+	// Return the membership of the user with this email address
+	Email string `url:"email,omitempty"`
+
+	// Return the members with this role
+	Role MembershipRole `url:"role,omitempty"`
+
+	ListOptions
+}
+
+type InvitationsOpts struct {
+	// Return the invitations sent to this email address
+	Email string `url:"email,omitempty"`
+
+	// Return the invitations with this status
+	Status InvitationStatus `url:"status,omitempty"`
+
+	// Order results by the creation time, e.g. "created_at:asc"
+	Sort string `url:"sort,omitempty"`
+
+	ListOptions
+}
+
+// OrganizationMembership is the membership of a user in an organization. The ID of the
+// member is its resource URI.
+type OrganizationMembership struct {
+	URI          string         `json:"uri"`
+	Role         MembershipRole `json:"role"`
+	User         *AboutMe       `json:"user"`
+	Organization string         `json:"organization"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+// OrganizationInvitation is an invitation to join an organization.
+type OrganizationInvitation struct {
+	URI          string           `json:"uri"`
+	Organization string           `json:"organization"`
+	Email        string           `json:"email"`
+	Status       InvitationStatus `json:"status"`
+	LastSentAt   time.Time        `json:"last_sent_at"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+
+	// URI of the user that accepted the invitation
+	User string `json:"user,omitempty"`
+}
+
+// membershipV2 is the API v2 representation of a membership.
+type membershipV2 struct {
+	URI          string         `json:"uri"`
+	Role         MembershipRole `json:"role"`
+	User         *userV2        `json:"user"`
+	Organization string         `json:"organization"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type membershipResponse struct {
+	Resource *membershipV2 `json:"resource"`
+}
+
+type membershipListResponse struct {
+	Collection []*membershipV2 `json:"collection"`
+	Pagination *Pagination     `json:"pagination"`
+}
+
+type invitationRequest struct {
+	Email string `json:"email"`
+}
+
+type invitationResponse struct {
+	Resource *OrganizationInvitation `json:"resource"`
+}
+
+type invitationListResponse struct {
+	Collection []*OrganizationInvitation `json:"collection"`
+	Pagination *Pagination               `json:"pagination"`
+}
+
+func (r *membershipListResponse) pagination() *Pagination {
+	return r.Pagination
+}
+
+func (r *invitationListResponse) pagination() *Pagination {
+	return r.Pagination
+}
+
+func (m *membershipV2) toMembership() *OrganizationMembership {
+	if m == nil {
+		return nil
+	}
+
+	return &OrganizationMembership{
+		URI:          m.URI,
+		Role:         m.Role,
+		User:         m.User.toAboutMe(),
+		Organization: m.Organization,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func (m *OrganizationMembership) String() string {
+	b := bytes.NewBufferString("")
+	b.WriteString(fmt.Sprintf("OrganizationMembership: uri:%v ", m.URI))
+	b.WriteString(fmt.Sprintf("Role:%v ", m.Role))
+	if m.User != nil {
+		b.WriteString(fmt.Sprintf("User:%v ", m.User.ID))
+	}
+	b.WriteString(fmt.Sprintf("Organization:%v", m.Organization))
+
+	return b.String()
+}
+
+func (i *OrganizationInvitation) String() string {
+	b := bytes.NewBufferString("")
+	b.WriteString(fmt.Sprintf("OrganizationInvitation: uri:%v ", i.URI))
+	b.WriteString(fmt.Sprintf("Email:%v ", i.Email))
+	b.WriteString(fmt.Sprintf("Status:%v", i.Status))
+
+	return b.String()
+}
+
+// ListMemberships returns the organization memberships matching the options.
+// Either the organization or the user has to be set.
+func (s *OrganizationsService) ListMemberships(ctx context.Context, opt *MembershipsOpts) ([]*OrganizationMembership, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	u, err := addUrlOptions(organizationMembershipsPath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ml := &membershipListResponse{}
+	resp, err := s.client.Do(ctx, req, ml)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	memberships := make([]*OrganizationMembership, 0, len(ml.Collection))
+	for _, m := range ml.Collection {
+		memberships = append(memberships, m.toMembership())
+	}
+
+	return memberships, resp, nil
+}
+
+// IterMemberships returns an Iterator over all the organization memberships matching the options.
+func (s *OrganizationsService) IterMemberships(opt *MembershipsOpts) *Iterator[*OrganizationMembership] {
+	o := MembershipsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*OrganizationMembership, *Response, error) {
+		o.PageToken = pageToken
+		return s.ListMemberships(ctx, &o)
+	})
+}
+
+// GetMembership returns a single organization membership by its UUID or URI.
+func (s *OrganizationsService) GetMembership(ctx context.Context, uuidOrURI string) (*OrganizationMembership, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(organizationMembershipsPath, uuidOrURI))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := &membershipResponse{}
+	resp, err := s.client.Do(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.Resource.toMembership(), resp, nil
+}
+
+// RemoveMembership removes a user from the organization by the UUID or URI of their membership.
+func (s *OrganizationsService) RemoveMembership(ctx context.Context, uuidOrURI string) (*Response, error) {
+	if s.client.version != APIV2 {
+		return nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Delete(resourcePath(organizationMembershipsPath, uuidOrURI))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListInvitations returns the invitations of the organization identified by its UUID or URI.
+func (s *OrganizationsService) ListInvitations(ctx context.Context, organization string, opt *InvitationsOpts) ([]*OrganizationInvitation, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	u, err := addUrlOptions(organizationInvitationsPath(organization), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	il := &invitationListResponse{}
+	resp, err := s.client.Do(ctx, req, il)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return il.Collection, resp, nil
+}
+
+// IterInvitations returns an Iterator over all the invitations of the organization matching the options.
+func (s *OrganizationsService) IterInvitations(organization string, opt *InvitationsOpts) *Iterator[*OrganizationInvitation] {
+	o := InvitationsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*OrganizationInvitation, *Response, error) {
+		o.PageToken = pageToken
+		return s.ListInvitations(ctx, organization, &o)
+	})
+}
+
+// GetInvitation returns a single invitation of an organization. The invitation may be given
+// as its UUID, in which case organization has to identify the organization, or as its full URI.
+func (s *OrganizationsService) GetInvitation(ctx context.Context, organization, invitation string) (*OrganizationInvitation, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(organizationInvitationsPath(organization), invitation))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := &invitationResponse{}
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i.Resource, resp, nil
+}
+
+// Invite sends an invitation to join the organization identified by its UUID or URI.
+func (s *OrganizationsService) Invite(ctx context.Context, organization, email string) (*OrganizationInvitation, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Post(organizationInvitationsPath(organization), &invitationRequest{Email: email})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := &invitationResponse{}
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i.Resource, resp, nil
+}
+
+// RevokeInvitation revokes a pending invitation. The invitation may be given as its UUID,
+// in which case organization has to identify the organization, or as its full URI.
+func (s *OrganizationsService) RevokeInvitation(ctx context.Context, organization, invitation string) (*Response, error) {
+	if s.client.version != APIV2 {
+		return nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Delete(resourcePath(organizationInvitationsPath(organization), invitation))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func organizationInvitationsPath(organization string) string {
+	return resourcePath(organizationsPath, organization) + "/" + invitationsPath
+}
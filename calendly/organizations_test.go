@@ -0,0 +1,139 @@
+package calendly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestOrganizationsService_ListMemberships() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+organizationMembershipsPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		assert.Equal("https://api.calendly.com/organizations/O1", r.URL.Query().Get("organization"))
+		assert.Equal("admin", r.URL.Query().Get("role"))
+		assert.Equal("10", r.URL.Query().Get("count"))
+		fmt.Fprint(w, `{"collection":[{"uri":"M1","role":"admin","organization":"https://api.calendly.com/organizations/O1",`+
+			`"user":{"uri":"U1","name":"Jane","email":"jane@example.com","avatar_url":"https://avatar"}}],`+
+			`"pagination":{"count":1,"next_page_token":"T2"}}`)
+	})
+
+	memberships, resp, err := suite.client.Organizations.ListMemberships(context.Background(), &MembershipsOpts{
+		Organization: "https://api.calendly.com/organizations/O1",
+		Role:         MembershipRoleAdmin,
+		ListOptions:  ListOptions{Count: 10},
+	})
+	assert.Nil(err)
+	assert.Equal("T2", resp.NextPageToken)
+
+	want := []*OrganizationMembership{{
+		URI:          "M1",
+		Role:         MembershipRoleAdmin,
+		Organization: "https://api.calendly.com/organizations/O1",
+		User: &AboutMe{
+			Type: "users",
+			ID:   "U1",
+			Attributes: &UserAttributes{
+				Name:   "Jane",
+				Email:  "jane@example.com",
+				Avatar: &Avatar{URL: "https://avatar"},
+			},
+		},
+	}}
+	assert.Equal(want, memberships)
+}
+
+func (suite *CalendlyClientTestSuite) TestOrganizationsService_GetMembership() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+organizationMembershipsPath+"/M1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		fmt.Fprint(w, `{"resource":{"uri":"M1","role":"owner"}}`)
+	})
+
+	m, _, err := suite.client.Organizations.GetMembership(context.Background(), "https://api.calendly.com/organization_memberships/M1")
+	assert.Nil(err)
+	assert.Equal(&OrganizationMembership{URI: "M1", Role: MembershipRoleOwner}, m)
+}
+
+func (suite *CalendlyClientTestSuite) TestOrganizationsService_RemoveMembership() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+organizationMembershipsPath+"/M1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := suite.client.Organizations.RemoveMembership(context.Background(), "M1")
+	assert.Nil(err)
+	assert.Equal(http.StatusNoContent, resp.StatusCode)
+}
+
+func (suite *CalendlyClientTestSuite) TestOrganizationsService_Invitations() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s/O1/%s", organizationsPath, invitationsPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal("pending", r.URL.Query().Get("status"))
+			fmt.Fprint(w, `{"collection":[{"uri":"I1","email":"a@example.com","status":"pending"}],"pagination":{"count":1}}`)
+		case http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Equal(`{"email":"b@example.com"}`+"\n", string(body))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"resource":{"uri":"I2","email":"b@example.com","status":"pending"}}`)
+		default:
+			suite.T().Errorf("unexpected method %v", r.Method)
+		}
+	})
+	suite.mux.HandleFunc(route+"/I1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	org := "https://api.calendly.com/organizations/O1"
+
+	invitations, _, err := suite.client.Organizations.ListInvitations(ctx, org, &InvitationsOpts{Status: InvitationStatusPending})
+	assert.Nil(err)
+	assert.Equal([]*OrganizationInvitation{{URI: "I1", Email: "a@example.com", Status: InvitationStatusPending}}, invitations)
+
+	invitation, _, err := suite.client.Organizations.Invite(ctx, org, "b@example.com")
+	assert.Nil(err)
+	assert.Equal(&OrganizationInvitation{URI: "I2", Email: "b@example.com", Status: InvitationStatusPending}, invitation)
+
+	_, err = suite.client.Organizations.RevokeInvitation(ctx, "O1", "I1")
+	assert.Nil(err)
+}
+
+func (suite *CalendlyClientTestSuite) TestOrganizationsService_MembershipJSON() {
+	assert := assert.New(suite.T())
+
+	data, err := json.Marshal(&OrganizationMembership{URI: "M1", Role: MembershipRoleOwner, Organization: "O1"})
+	assert.Nil(err)
+	assert.Equal(`{"uri":"M1","role":"owner","user":null,"organization":"O1",`+
+		`"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`, string(data))
+}
+
+func (suite *CalendlyClientTestSuite) TestOrganizationsService_V1Unsupported() {
+	assert := assert.New(suite.T())
+
+	_, _, err := suite.client.Organizations.ListMemberships(context.Background(), &MembershipsOpts{Organization: "O1"})
+	assert.Equal(errUnsupportedVersion, err)
+
+	_, err = suite.client.Organizations.RemoveMembership(context.Background(), "M1")
+	assert.Equal(errUnsupportedVersion, err)
+
+	_, _, err = suite.client.Organizations.Invite(context.Background(), "O1", "new@example.com")
+	assert.Equal(errUnsupportedVersion, err)
+}
@@ -0,0 +1,140 @@
+package calendly
+
+import (
+	"context"
+)
+
+// ListOptions specifies the optional paging parameters of list methods.
+// They are only honoured by API v2, v1 list endpoints always return a single page.
+type ListOptions struct {
+	// The number of rows to return per page
+	Count int `url:"count,omitempty"`
+
+	// The token to pass to get the next or previous portion of the collection
+	PageToken string `url:"page_token,omitempty"`
+}
+
+// Pagination is the paging information returned by API v2 collection endpoints.
+type Pagination struct {
+	Count             int    `json:"count"`
+	NextPage          string `json:"next_page"`
+	PreviousPage      string `json:"previous_page"`
+	NextPageToken     string `json:"next_page_token"`
+	PreviousPageToken string `json:"previous_page_token"`
+}
+
+// paginatedResponse is implemented by the collection envelopes that carry paging information.
+type paginatedResponse interface {
+	pagination() *Pagination
+}
+
+// populatePageValues copies the paging information of a decoded collection to the Response.
+func (r *Response) populatePageValues(p *Pagination) {
+	if p == nil {
+		return
+	}
+
+	r.Count = p.Count
+	r.NextPage = p.NextPage
+	r.PreviousPage = p.PreviousPage
+	r.NextPageToken = p.NextPageToken
+	r.PreviousPageToken = p.PreviousPageToken
+}
+
+// pageFunc fetches the page identified by pageToken, an empty token denoting the first page.
+type pageFunc[T any] func(ctx context.Context, pageToken string) ([]T, *Response, error)
+
+// Iterator walks through all the items of a paginated list endpoint, fetching
+// pages lazily as they are needed:
+//
+//	it := client.ScheduledEvents.Iter(opt)
+//	for it.Next(ctx) {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator[T any] struct {
+	fetch pageFunc[T]
+
+	page      []T
+	idx       int
+	pageToken string
+	started   bool
+	resp      *Response
+	err       error
+}
+
+// NewIterator returns an Iterator over the pages returned by fetch, starting at the page identified
+// by pageToken. Fetch receives the token of the page to return, an empty token denoting the first page,
+// and reports the token of the following one in the NextPageToken of its Response.
+// It lets fakes of the list methods be iterated like the services.
+func NewIterator[T any](pageToken string, fetch func(ctx context.Context, pageToken string) ([]T, *Response, error)) *Iterator[T] {
+	return newIterator(pageToken, fetch)
+}
+
+// newIterator returns an Iterator starting at the page identified by pageToken.
+func newIterator[T any](pageToken string, fetch pageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch, pageToken: pageToken, idx: -1}
+}
+
+// Next advances the iterator to the next item, fetching the following page when the
+// current one is exhausted. It returns false when there are no more items or an error occurred.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx+1 >= len(it.page) {
+		if it.started && it.pageToken == "" {
+			return false
+		}
+
+		page, resp, err := it.fetch(ctx, it.pageToken)
+		it.started = true
+		it.resp = resp
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page, it.idx = page, -1
+		it.pageToken = ""
+		if resp != nil {
+			it.pageToken = resp.NextPageToken
+		}
+	}
+
+	it.idx++
+	return true
+}
+
+// Value returns the current item. It is only valid after a call to Next returned true.
+func (it *Iterator[T]) Value() T {
+	var zero T
+	if it.idx < 0 || it.idx >= len(it.page) {
+		return zero
+	}
+
+	return it.page[it.idx]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last fetched page.
+func (it *Iterator[T]) Response() *Response {
+	return it.resp
+}
+
+// ListAll drains the iterator and returns all of its remaining items.
+func ListAll[T any](ctx context.Context, it *Iterator[T]) ([]T, error) {
+	var all []T
+	for it.Next(ctx) {
+		all = append(all, it.Value())
+	}
+
+	return all, it.Err()
+}
@@ -0,0 +1,74 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestIterator_Pages() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s", scheduledEventsPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("2", r.URL.Query().Get("count"))
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprint(w, `{"collection":[{"uri":"E1"},{"uri":"E2"}],"pagination":{"count":2,"next_page_token":"P2"}}`)
+		case "P2":
+			fmt.Fprint(w, `{"collection":[{"uri":"E3"}],"pagination":{"count":1,"previous_page_token":"P1"}}`)
+		default:
+			http.Error(w, "unexpected page", http.StatusBadRequest)
+		}
+	})
+
+	it := suite.client.ScheduledEvents.Iter(&ScheduledEventsOpts{ListOptions: ListOptions{Count: 2}})
+	var uris []string
+	for it.Next(context.Background()) {
+		uris = append(uris, it.Value().URI)
+	}
+
+	assert.Nil(it.Err())
+	assert.Equal([]string{"E1", "E2", "E3"}, uris)
+	assert.Equal(1, it.Response().Count)
+	assert.Equal("P1", it.Response().PreviousPageToken)
+	assert.Equal("", it.Response().NextPageToken)
+	assert.False(it.Next(context.Background()))
+}
+
+func (suite *CalendlyClientTestSuite) TestIterator_Error() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s/E1/%s", scheduledEventsPath, inviteesPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_token") == "" {
+			fmt.Fprint(w, `{"collection":[{"uri":"I1"}],"pagination":{"next_page_token":"P2"}}`)
+			return
+		}
+		http.Error(w, "Server Error", http.StatusInternalServerError)
+	})
+
+	invitees, err := ListAll(context.Background(), suite.client.Invitees.Iter("E1", nil))
+	assert.NotNil(err)
+	assert.Equal([]*Invitee{{URI: "I1"}}, invitees)
+}
+
+func (suite *CalendlyClientTestSuite) TestIterator_SinglePageV1() {
+	assert := assert.New(suite.T())
+	route := fmt.Sprintf("/%s", eventTypesPath)
+
+	calls := 0
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"data":[{"id":"123"},{"id":"456"}]}`)
+	})
+
+	eventTypes, err := ListAll(context.Background(), suite.client.EventTypes.Iter(nil))
+	assert.Nil(err)
+	assert.Equal([]*EventType{{ID: "123"}, {ID: "456"}}, eventTypes)
+	assert.Equal(1, calls)
+}
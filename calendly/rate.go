@@ -0,0 +1,110 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRateLimit          = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// Rate represents the rate limit of the token used by the client,
+// as reported by the X-RateLimit headers of the last response.
+type Rate struct {
+	// The number of requests allowed per window
+	Limit int `json:"limit"`
+
+	// The number of requests remaining in the current window
+	Remaining int `json:"remaining"`
+
+	// The time at which the current window resets
+	Reset time.Time `json:"reset"`
+}
+
+func (r Rate) String() string {
+	return fmt.Sprintf("Rate: limit:%v remaining:%v reset:%v", r.Limit, r.Remaining, r.Reset)
+}
+
+// exhausted reports whether no requests remain before the window resets.
+func (r Rate) exhausted(now time.Time) bool {
+	return r.Limit > 0 && r.Remaining == 0 && now.Before(r.Reset)
+}
+
+// WithRateLimitWait is a client option that makes requests wait for the rate limit window
+// to reset when the last response reported that no requests remain, instead of sending
+// requests that are known to be rejected.
+func WithRateLimitWait() ClientOpt {
+	return func(c *Client) {
+		c.rateLimitWait = true
+	}
+}
+
+// Rate returns the rate limit reported by the most recent API response.
+func (c *Client) Rate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	return c.rate
+}
+
+// updateRate records the rate limit of a response, ignoring responses without rate limit headers.
+func (c *Client) updateRate(r Rate) {
+	if r.Limit == 0 {
+		return
+	}
+
+	c.rateMu.Lock()
+	c.rate = r
+	c.rateMu.Unlock()
+}
+
+// waitForRateLimit blocks until the known exhausted rate limit window resets, when enabled.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if !c.rateLimitWait {
+		return nil
+	}
+
+	rate := c.Rate()
+	if !rate.exhausted(time.Now()) {
+		return nil
+	}
+
+	t := time.NewTimer(time.Until(rate.Reset))
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// parseRate parses the rate limit headers of a response. The reset header holds the
+// number of seconds until the window resets.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if r == nil {
+		return rate
+	}
+
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateLimitRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateLimitReset); reset != "" {
+		if secs, err := strconv.Atoi(reset); err == nil {
+			rate.Reset = time.Now().Add(time.Duration(secs) * time.Second)
+		}
+	}
+
+	return rate
+}
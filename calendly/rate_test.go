@@ -0,0 +1,98 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestDo_rate() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateLimitRemaining, "59")
+		w.Header().Set(headerRateLimitReset, "30")
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := suite.client.Get(".")
+	resp, err := suite.client.Do(context.Background(), req, nil)
+	assert.Nil(err)
+
+	assert.Equal(60, resp.Rate.Limit)
+	assert.Equal(59, resp.Rate.Remaining)
+	assert.WithinDuration(time.Now().Add(30*time.Second), resp.Rate.Reset, 2*time.Second)
+	assert.Equal(resp.Rate, suite.client.Rate())
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_rateLimitError() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateLimitRemaining, "0")
+		w.Header().Set(headerRateLimitReset, "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"message":"Rate limit exceeded"}`)
+	})
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	rateErr, ok := err.(*RateLimitError)
+	assert.True(ok)
+	assert.Equal(0, rateErr.Rate.Remaining)
+	assert.Equal("Rate limit exceeded", rateErr.Message)
+	assert.Contains(rateErr.Error(), "resets in 30s")
+	assert.Equal(0, suite.client.Rate().Remaining)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_rateLimitErrorWithoutReset() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"message":"Rate limit exceeded"}`)
+	})
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	rateErr, ok := err.(*RateLimitError)
+	assert.True(ok)
+	assert.True(rateErr.Rate.Reset.IsZero())
+	assert.True(strings.HasSuffix(rateErr.Error(), "Rate limit exceeded rate limit exceeded"))
+	assert.NotContains(rateErr.Error(), "resets in")
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_rateLimitWait() {
+	assert := assert.New(suite.T())
+	WithRateLimitWait()(suite.client)
+
+	calls := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{}`)
+	})
+
+	suite.client.updateRate(Rate{Limit: 60, Remaining: 0, Reset: time.Now().Add(time.Hour)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(ctx, req, nil)
+	assert.Equal(context.DeadlineExceeded, err)
+	assert.Equal(0, calls)
+
+	suite.client.updateRate(Rate{Limit: 60, Remaining: 0, Reset: time.Now().Add(10 * time.Millisecond)})
+	req, _ = suite.client.Get(".")
+	_, err = suite.client.Do(context.Background(), req, nil)
+	assert.Nil(err)
+	assert.Equal(1, calls)
+}
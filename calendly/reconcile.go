@@ -0,0 +1,225 @@
+package calendly
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ReconcileOpts selects the subscriptions managed by the reconciler.
+type ReconcileOpts struct {
+	// Callback URL prefix of the managed subscriptions, e.g. "https://hooks.example.com/calendly/".
+	// Managed subscriptions that are not desired are deleted. When empty only the subscriptions
+	// to the URL of a desired subscription are managed, the others are never deleted.
+	URLPrefix string
+
+	// Only compute the plan, without creating or deleting subscriptions
+	DryRun bool
+}
+
+// WebhookPlan lists the changes converging the webhook subscriptions to a desired set.
+type WebhookPlan struct {
+	// Subscriptions to create
+	Create []*WebhooksOpts
+
+	// Managed subscriptions that are not desired, are disabled or subscribe to other events
+	Delete []*Webhook
+
+	// Subscriptions already matching a desired subscription
+	Keep []*Webhook
+}
+
+// Empty reports whether the subscriptions already match the desired set.
+func (p *WebhookPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Delete) == 0
+}
+
+// String returns the plan in a human readable form, one change per line, e.g. to
+// print it on a dry run.
+func (p *WebhookPlan) String() string {
+	b := bytes.NewBufferString("")
+	for _, w := range p.Keep {
+		b.WriteString(fmt.Sprintf("  keep   %v\n", webhookRef(w)))
+	}
+	for _, w := range p.Delete {
+		b.WriteString(fmt.Sprintf("- delete %v\n", webhookRef(w)))
+	}
+	for _, o := range p.Create {
+		b.WriteString(fmt.Sprintf("+ create %v %v\n", o.Url, o.Events))
+	}
+
+	return b.String()
+}
+
+// Plan compares the desired subscriptions, identified by their URL, events and scope, with
+// the existing managed ones and returns the changes converging them. With API v2 the user scoped
+// subscriptions of the current user are compared, as well as the organization scoped ones
+// when any is desired. Subscriptions created by other users or integrations are left alone
+// as long as their URL is neither desired nor matches the URLPrefix of the options.
+// Signing keys are not returned by Calendly and are not compared.
+func (s *WebhooksService) Plan(ctx context.Context, desired []*WebhooksOpts, opt *ReconcileOpts) (*WebhookPlan, error) {
+	if opt == nil {
+		opt = &ReconcileOpts{}
+	}
+
+	existing, err := s.listManaged(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]*WebhooksOpts{}
+	urls := map[string]bool{}
+	var order []string
+	for _, o := range desired {
+		urls[o.Url] = true
+		k := webhookKey(o.Url, o.Events, o.Scope)
+		if _, ok := wanted[k]; !ok {
+			order = append(order, k)
+		}
+		wanted[k] = o
+	}
+
+	plan := &WebhookPlan{}
+	kept := map[string]bool{}
+	for _, w := range existing {
+		attrs := w.Attributes
+		if attrs == nil {
+			attrs = &WebhookAttributes{}
+		}
+		if !urls[attrs.URL] && (opt.URLPrefix == "" || !strings.HasPrefix(attrs.URL, opt.URLPrefix)) {
+			continue
+		}
+
+		// a subscription is kept once, its duplicates are deleted
+		k := webhookKey(attrs.URL, attrs.Events, attrs.Scope)
+		if _, ok := wanted[k]; ok && !kept[k] && attrs.State != WebhookStateDisabled {
+			kept[k] = true
+			plan.Keep = append(plan.Keep, w)
+			continue
+		}
+		plan.Delete = append(plan.Delete, w)
+	}
+
+	for _, k := range order {
+		if !kept[k] {
+			plan.Create = append(plan.Create, wanted[k])
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply executes a plan, creating the subscriptions before deleting the others so that a
+// failure never leaves an endpoint without a subscription. Calendly rejects a second
+// subscription to the same URL and scope, so the subscriptions replacing a deleted one
+// are created last. It stops at the first failure, re-running Plan and Apply resumes
+// where it stopped.
+func (s *WebhooksService) Apply(ctx context.Context, plan *WebhookPlan) error {
+	deleted := map[string]bool{}
+	for _, w := range plan.Delete {
+		if w.Attributes != nil {
+			deleted[webhookKey(w.Attributes.URL, nil, w.Attributes.Scope)] = true
+		}
+	}
+
+	var replacing []*WebhooksOpts
+	for _, o := range plan.Create {
+		if deleted[webhookKey(o.Url, nil, o.Scope)] {
+			replacing = append(replacing, o)
+			continue
+		}
+		if _, _, err := s.Create(ctx, o); err != nil {
+			return err
+		}
+	}
+
+	for _, w := range plan.Delete {
+		var err error
+		if w.URI != "" {
+			_, err = s.DeleteByURI(ctx, w.URI)
+		} else {
+			_, err = s.Delete(ctx, w.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, o := range replacing {
+		if _, _, err := s.Create(ctx, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reconcile converges the managed subscriptions to the desired set and returns the executed
+// plan. On a dry run the plan is only computed. Reconciling an already converged set is a no-op.
+func (s *WebhooksService) Reconcile(ctx context.Context, desired []*WebhooksOpts, opt *ReconcileOpts) (*WebhookPlan, error) {
+	plan, err := s.Plan(ctx, desired, opt)
+	if err != nil || (opt != nil && opt.DryRun) {
+		return plan, err
+	}
+
+	return plan, s.Apply(ctx, plan)
+}
+
+// listManaged returns the existing subscriptions the desired set is compared with.
+func (s *WebhooksService) listManaged(ctx context.Context, desired []*WebhooksOpts) ([]*Webhook, error) {
+	if s.client.version != APIV2 {
+		return ListAll(ctx, s.Iter(nil))
+	}
+
+	existing, err := ListAll(ctx, s.IterSubscriptions(&WebhookSubscriptionsOpts{Scope: WebhookScopeUser}))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range desired {
+		if o.Scope == WebhookScopeOrganization {
+			org, err := ListAll(ctx, s.IterSubscriptions(&WebhookSubscriptionsOpts{Scope: WebhookScopeOrganization}))
+			if err != nil {
+				return nil, err
+			}
+
+			return append(existing, org...), nil
+		}
+	}
+
+	return existing, nil
+}
+
+// webhookKey identifies a subscription by its URL, set of events and scope.
+func webhookKey(url string, events []EventHookType, scope WebhookScope) string {
+	names := make([]string, 0, len(events))
+	seen := map[EventHookType]bool{}
+	for _, e := range events {
+		if !seen[e] {
+			seen[e] = true
+			names = append(names, string(e))
+		}
+	}
+	sort.Strings(names)
+
+	if scope == "" {
+		scope = WebhookScopeUser
+	}
+
+	return fmt.Sprintf("%v|%v|%v", scope, url, strings.Join(names, ","))
+}
+
+// webhookRef describes an existing subscription in a plan.
+func webhookRef(w *Webhook) string {
+	ref := w.URI
+	if ref == "" {
+		ref = fmt.Sprint(w.ID)
+	}
+	if w.Attributes != nil {
+		ref = fmt.Sprintf("%v %v %v", ref, w.Attributes.URL, w.Attributes.Events)
+	}
+
+	return ref
+}
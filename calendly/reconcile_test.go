@@ -0,0 +1,169 @@
+package calendly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// handleWebhookSubscriptionsV2 serves an in-memory set of user scoped webhook subscriptions.
+func (suite *CalendlyClientTestSuite) handleWebhookSubscriptionsV2(subscriptions map[string]string) *[]string {
+	var mu sync.Mutex
+	var calls []string
+
+	suite.mux.HandleFunc("/"+webhookSubscriptionsPath, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			var items []string
+			for _, s := range subscriptions {
+				items = append(items, s)
+			}
+			fmt.Fprintf(w, `{"collection":[%v],"pagination":{"count":%d}}`, strings.Join(items, ","), len(items))
+		case http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			calls = append(calls, "create "+strings.TrimSpace(string(body)))
+
+			uri := fmt.Sprintf("https://api.calendly.com/webhook_subscriptions/N%d", len(subscriptions))
+			var req webhookV2Request
+			suite.Nil(json.Unmarshal(body, &req))
+			events := make([]string, 0, len(req.Events))
+			for _, e := range req.Events {
+				events = append(events, `"`+string(e)+`"`)
+			}
+			subscriptions[uri] = fmt.Sprintf(`{"uri":%q,"callback_url":%q,"state":"active","scope":"user","events":[%v]}`,
+				uri, req.URL, strings.Join(events, ","))
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"resource":%v}`, subscriptions[uri])
+		}
+	})
+	suite.mux.HandleFunc("/"+webhookSubscriptionsPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		uri := "https://api.calendly.com" + r.URL.Path
+		calls = append(calls, "delete "+uri)
+		delete(subscriptions, uri)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return &calls
+}
+
+func (suite *CalendlyClientTestSuite) TestWebhooksService_Reconcile() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	suite.handleAboutMeV2()
+
+	subscriptions := map[string]string{
+		// matches the first desired subscription, events in another order
+		"https://api.calendly.com/webhook_subscriptions/W1": `{"uri":"https://api.calendly.com/webhook_subscriptions/W1",` +
+			`"callback_url":"https://example.com/a","state":"active","scope":"user",` +
+			`"events":["invitee.canceled","invitee.created"]}`,
+		// same URL as the second desired subscription but other events
+		"https://api.calendly.com/webhook_subscriptions/W2": `{"uri":"https://api.calendly.com/webhook_subscriptions/W2",` +
+			`"callback_url":"https://example.com/b","state":"active","scope":"user","events":["invitee.created"]}`,
+		// not desired, under the managed prefix
+		"https://api.calendly.com/webhook_subscriptions/W3": `{"uri":"https://api.calendly.com/webhook_subscriptions/W3",` +
+			`"callback_url":"https://example.com/c","state":"active","scope":"user","events":["invitee.created"]}`,
+		// created by another integration
+		"https://api.calendly.com/webhook_subscriptions/W4": `{"uri":"https://api.calendly.com/webhook_subscriptions/W4",` +
+			`"callback_url":"https://integration.example.org/hook","state":"active","scope":"user","events":["invitee.created"]}`,
+	}
+	calls := suite.handleWebhookSubscriptionsV2(subscriptions)
+
+	desired := []*WebhooksOpts{
+		{Url: "https://example.com/a", Events: []EventHookType{InviteeCreatedHookType, InviteeCanceledHookType}},
+		{Url: "https://example.com/b", Events: []EventHookType{InviteeNoShowCreatedHookType}},
+		{Url: "https://example.com/d", Events: []EventHookType{InviteeCreatedHookType}},
+	}
+	opt := &ReconcileOpts{URLPrefix: "https://example.com/", DryRun: true}
+
+	plan, err := suite.client.Webhooks.Reconcile(context.Background(), desired, opt)
+	assert.Nil(err)
+	assert.Len(plan.Keep, 1)
+	assert.Len(plan.Delete, 2)
+	assert.Equal([]*WebhooksOpts{desired[1], desired[2]}, plan.Create)
+	assert.Contains(plan.String(), "+ create https://example.com/b [invitee_no_show.created]")
+	assert.NotContains(plan.String(), "integration.example.org")
+	assert.Empty(*calls)
+
+	opt.DryRun = false
+	plan, err = suite.client.Webhooks.Reconcile(context.Background(), desired, opt)
+	assert.Nil(err)
+	assert.False(plan.Empty())
+	assert.Len(*calls, 4)
+	// the new URL is subscribed before anything is deleted, the replaced one after its deletion
+	assert.True(strings.HasPrefix((*calls)[0], `create {"url":"https://example.com/d"`))
+	assert.True(strings.HasPrefix((*calls)[1], "delete "))
+	assert.True(strings.HasPrefix((*calls)[2], "delete "))
+	assert.True(strings.HasPrefix((*calls)[3], `create {"url":"https://example.com/b"`))
+	assert.Len(subscriptions, 4)
+	assert.Contains(subscriptions, "https://api.calendly.com/webhook_subscriptions/W4")
+
+	// converged, a re-run changes nothing
+	plan, err = suite.client.Webhooks.Reconcile(context.Background(), desired, opt)
+	assert.Nil(err)
+	assert.True(plan.Empty())
+	assert.Len(plan.Keep, 3)
+	assert.Len(*calls, 4)
+}
+
+func (suite *CalendlyClientTestSuite) TestWebhooksService_PlanUnmanaged() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	suite.handleAboutMeV2()
+
+	suite.mux.HandleFunc("/"+webhookSubscriptionsPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != string(WebhookScopeOrganization) {
+			fmt.Fprint(w, `{"collection":[],"pagination":{"count":0}}`)
+			return
+		}
+		fmt.Fprint(w, `{"collection":[{"uri":"W1","callback_url":"https://example.com/a","state":"active",`+
+			`"scope":"organization","events":["invitee.created"]},`+
+			`{"uri":"W2","callback_url":"https://other.example.org/hook","state":"active",`+
+			`"scope":"organization","events":["invitee.created"]}],"pagination":{"count":2}}`)
+	})
+
+	// without a prefix only the subscriptions to desired URLs are managed
+	plan, err := suite.client.Webhooks.Plan(context.Background(), []*WebhooksOpts{
+		{Url: "https://example.com/a", Events: []EventHookType{InviteeCanceledHookType}, Scope: WebhookScopeOrganization},
+	}, nil)
+	assert.Nil(err)
+	assert.Empty(plan.Keep)
+	assert.Len(plan.Delete, 1)
+	assert.Equal("W1", plan.Delete[0].URI)
+	assert.Len(plan.Create, 1)
+}
+
+func (suite *CalendlyClientTestSuite) TestWebhooksService_PlanV1() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/"+webhooksPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"id":1,"attributes":{"url":"https://example.com/a","state":"active","events":["invitee.created"]}},`+
+			`{"id":2,"attributes":{"url":"https://example.com/a","state":"active","events":["invitee.created"]}},`+
+			`{"id":3,"attributes":{"url":"https://example.com/b","state":"disabled","events":["invitee.created"]}}]}`)
+	})
+
+	plan, err := suite.client.Webhooks.Plan(context.Background(), []*WebhooksOpts{
+		{Url: "https://example.com/a", Events: []EventHookType{InviteeCreatedHookType}},
+		{Url: "https://example.com/b", Events: []EventHookType{InviteeCreatedHookType}},
+	}, nil)
+	assert.Nil(err)
+
+	assert.Len(plan.Keep, 1)
+	assert.Equal(int64(1), plan.Keep[0].ID)
+	// the duplicate and the disabled subscription are replaced
+	assert.Len(plan.Delete, 2)
+	assert.Len(plan.Create, 1)
+	assert.Equal("https://example.com/b", plan.Create[0].Url)
+}
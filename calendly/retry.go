@@ -0,0 +1,213 @@
+package calendly
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/context/ctxhttp"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+)
+
+// RetryPolicy configures how Client.Do retries requests that failed with a transient network
+// error, a 429 Too Many Requests or a 5xx response. Requests that could not be authorized or
+// failed otherwise before reaching the network are never retried.
+//
+// Requests with non idempotent methods such as the POST of Webhooks.Create are only retried
+// after a 429, which Calendly sends before processing the request, unless RetryNonIdempotent is set.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+
+	// Backoff before the first retry, doubled for every following one
+	MinBackoff time.Duration
+
+	// Upper bound of the exponential backoff
+	MaxBackoff time.Duration
+
+	// Randomize every backoff between half and all of its value, to avoid retrying in lockstep
+	Jitter bool
+
+	// Retry non idempotent requests after network errors and 5xx responses as well
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is a sensible policy to pass to WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+	Jitter:      true,
+}
+
+// WithRetryPolicy is a client option for retrying failed requests. Retries are disabled by default.
+func WithRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) {
+		c.retryPolicy = p
+	}
+}
+
+// backoff returns how long to wait before the given retry, starting at 1.
+// A Retry-After or rate limit reset header of the failed response takes precedence
+// when it asks for a longer wait.
+func (p *RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	wait := p.MinBackoff
+	for i := 1; i < retry; i++ {
+		wait *= 2
+		if p.MaxBackoff > 0 && wait >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter && wait > 1 {
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)))
+	}
+
+	if after, ok := retryAfter(resp); ok && after > wait {
+		wait = after
+	}
+
+	return wait
+}
+
+// shouldRetry reports whether the outcome of an attempt is worth retrying.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		// errors of the credentials or the request itself would happen again
+		if !isTransient(err) {
+			return false
+		}
+
+		return p.RetryNonIdempotent || isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return p.RetryNonIdempotent || isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// isTransient reports whether an error sending a request is a network failure which may
+// not happen again, such as a timeout, a refused or reset connection or a truncated response.
+func isTransient(err error) bool {
+	// The http.Client wraps every error in a *url.Error, which is a net.Error itself
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date,
+// falling back to the number of seconds until the rate limit window resets.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	if v := resp.Header.Get(headerRetryAfter); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return time.Until(t), true
+		}
+	}
+
+	if v := resp.Header.Get(headerRateLimitReset); v != "" && resp.StatusCode == http.StatusTooManyRequests {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+	}
+
+	return 0, false
+}
+
+// canRewind reports whether the body of a request can be sent again.
+// Requests built by NewRequest can always be rewound.
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindBody resets the body of a request that has already been sent so it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+
+	req.Body = body
+	return nil
+}
+
+// send performs the request, retrying it according to the retry policy of the client.
+// It returns the response of the last attempt and the number of retries made.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, int, error) {
+	p := c.retryPolicy
+	for retry := 0; ; retry++ {
+		if retry > 0 {
+			if err := rewindBody(req); err != nil {
+				return nil, retry, err
+			}
+		}
+
+		resp, err := ctxhttp.Do(ctx, c.client, req)
+		if retry+1 >= p.MaxAttempts || !canRewind(req) || !p.shouldRetry(req, resp, err) {
+			return resp, retry, err
+		}
+
+		wait := p.backoff(retry+1, resp)
+		if resp != nil {
+			io.CopyN(ioutil.Discard, resp.Body, 512)
+			resp.Body.Close()
+		}
+
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, retry, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package calendly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testRetryPolicy retries quickly so the tests do not sleep.
+var testRetryPolicy = RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+func (suite *CalendlyClientTestSuite) TestDo_retryServerError() {
+	assert := assert.New(suite.T())
+	WithRetryPolicy(testRetryPolicy)(suite.client)
+
+	calls := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"email":"echo@echo.com"}`)
+	})
+
+	req, _ := suite.client.Get("echo")
+	echo := &Echo{}
+	resp, err := suite.client.Do(context.Background(), req, echo)
+
+	assert.Nil(err)
+	assert.Equal(3, calls)
+	assert.Equal(2, resp.Retries)
+	assert.Equal("echo@echo.com", echo.Email)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_retryGivesUp() {
+	assert := assert.New(suite.T())
+	WithRetryPolicy(testRetryPolicy)(suite.client)
+
+	calls := 0
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	req, _ := suite.client.Get(".")
+	resp, err := suite.client.Do(context.Background(), req, nil)
+
+	assert.NotNil(err)
+	assert.Equal(3, calls)
+	assert.Equal(http.StatusBadGateway, resp.StatusCode)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_retryNonIdempotent() {
+	assert := assert.New(suite.T())
+	WithRetryPolicy(testRetryPolicy)(suite.client)
+
+	var bodies []string
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		switch len(bodies) {
+		case 1:
+			w.Header().Set(headerRetryAfter, "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			http.Error(w, "Server Error", http.StatusInternalServerError)
+		}
+	})
+
+	req, _ := suite.client.Post(".", &Echo{Email: "echo@echo.com"})
+	_, err := suite.client.Do(context.Background(), req, nil)
+
+	// the 429 is retried with the same body, the 500 is not replayed
+	assert.NotNil(err)
+	assert.Equal([]string{`{"email":"echo@echo.com"}` + "\n", `{"email":"echo@echo.com"}` + "\n"}, bodies)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_retryContextCanceled() {
+	assert := assert.New(suite.T())
+	WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Hour})(suite.client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	})
+
+	req, _ := suite.client.Get(".")
+	_, err := suite.client.Do(ctx, req, nil)
+
+	assert.Equal(context.Canceled, err)
+}
+
+func (suite *CalendlyClientTestSuite) TestDo_retryTransientErrors() {
+	assert := assert.New(suite.T())
+
+	testCases := []struct {
+		err     error
+		retried bool
+	}{
+		{io.EOF, true},
+		{&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}, true},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, true},
+		{errors.New("go-calendly: unsupported request"), false},
+		{&AuthError{Err: errMissingToken}, false},
+	}
+
+	for _, tc := range testCases {
+		attempts := 0
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, tc.err
+		})
+		client := NewClient(&http.Client{Transport: transport}, WithRetryPolicy(testRetryPolicy))
+
+		req, _ := client.Get(".")
+		_, err := client.Do(context.Background(), req, nil)
+
+		assert.True(errors.Is(err, tc.err), "%v", tc.err)
+		if tc.retried {
+			assert.Equal(testRetryPolicy.MaxAttempts, attempts, "%v", tc.err)
+		} else {
+			assert.Equal(1, attempts, "%v", tc.err)
+		}
+	}
+}
+
+// roundTripFunc is an http.RoundTripper calling itself.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	assert.Equal(t, time.Second, p.backoff(1, nil))
+	assert.Equal(t, 2*time.Second, p.backoff(2, nil))
+	assert.Equal(t, 4*time.Second, p.backoff(3, nil))
+	assert.Equal(t, 5*time.Second, p.backoff(4, nil))
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set(headerRateLimitReset, "10")
+	assert.Equal(t, 10*time.Second, p.backoff(1, resp))
+
+	resp.Header.Set(headerRetryAfter, "7")
+	assert.Equal(t, 7*time.Second, p.backoff(1, resp))
+
+	p.Jitter = true
+	for i := 0; i < 10; i++ {
+		wait := p.backoff(2, nil)
+		assert.True(t, wait >= time.Second && wait < 2*time.Second)
+	}
+}
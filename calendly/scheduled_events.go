@@ -0,0 +1,194 @@
+package calendly
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	scheduledEventsPath = "scheduled_events"
+
+	// Scheduled event statuses
+	EventStatusActive   EventStatus = "active"
+	EventStatusCanceled EventStatus = "canceled"
+
+	// Scheduled event sort orders
+	SortStartTimeAsc  = "start_time:asc"
+	SortStartTimeDesc = "start_time:desc"
+)
+
+// ScheduledEventsAPI is the interface of the scheduled events service, implemented by ScheduledEventsService.
+type ScheduledEventsAPI interface {
+	List(ctx context.Context, opt *ScheduledEventsOpts) ([]*ScheduledEvent, *Response, error)
+	Iter(opt *ScheduledEventsOpts) *Iterator[*ScheduledEvent]
+	Get(ctx context.Context, uuidOrURI string) (*ScheduledEvent, *Response, error)
+}
+
+// ScheduledEventsService gives access to the meetings booked with Calendly. API v2 only.
+type ScheduledEventsService apiService
+
+// Status of a scheduled event
+type EventStatus string
+
+type ScheduledEventsOpts struct {
+	// Return events scheduled with the user associated with this URI
+	User string `url:"user,omitempty"`
+
+	// Return events scheduled with the organization associated with this URI
+	Organization string `url:"organization,omitempty"`
+
+	// Return events scheduled with the invitee associated with this email address
+	InviteeEmail string `url:"invitee_email,omitempty"`
+
+	// Whether the scheduled event is active or canceled
+	Status EventStatus `url:"status,omitempty"`
+
+	// Include events with start times after this time
+	MinStartTime time.Time `url:"min_start_time,omitempty"`
+
+	// Include events with start times prior to this time
+	MaxStartTime time.Time `url:"max_start_time,omitempty"`
+
+	// Order results by the start time, e.g. SortStartTimeAsc
+	Sort string `url:"sort,omitempty"`
+
+	ListOptions
+}
+
+type ScheduledEvent struct {
+	URI             string             `json:"uri"`
+	Name            string             `json:"name"`
+	Status          EventStatus        `json:"status"`
+	StartTime       time.Time          `json:"start_time"`
+	EndTime         time.Time          `json:"end_time"`
+	EventType       string             `json:"event_type"`
+	Location        *Location          `json:"location,omitempty"`
+	InviteesCounter *InviteesCounter   `json:"invitees_counter,omitempty"`
+	Memberships     []*EventMembership `json:"event_memberships,omitempty"`
+	Guests          []*EventGuest      `json:"event_guests,omitempty"`
+	Cancellation    *Cancellation      `json:"cancellation,omitempty"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// Location where a scheduled event takes place
+type Location struct {
+	// Kind of the location, e.g. "physical", "zoom" or "outbound_call"
+	Type     string `json:"type"`
+	Location string `json:"location,omitempty"`
+	JoinURL  string `json:"join_url,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+type InviteesCounter struct {
+	Total  int `json:"total"`
+	Active int `json:"active"`
+	Limit  int `json:"limit"`
+}
+
+// A host of a scheduled event
+type EventMembership struct {
+	User      string `json:"user"`
+	UserEmail string `json:"user_email,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+}
+
+// An additional guest invited to a scheduled event
+type EventGuest struct {
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Cancellation struct {
+	CanceledBy   string    `json:"canceled_by"`
+	Reason       string    `json:"reason"`
+	CancelerType string    `json:"canceler_type"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type scheduledEventResponse struct {
+	Resource *ScheduledEvent `json:"resource"`
+}
+
+type scheduledEventListResponse struct {
+	Collection []*ScheduledEvent `json:"collection"`
+	Pagination *Pagination       `json:"pagination"`
+}
+
+func (r *scheduledEventListResponse) pagination() *Pagination {
+	return r.Pagination
+}
+
+func (e *ScheduledEvent) String() string {
+	b := bytes.NewBufferString("")
+	b.WriteString(fmt.Sprintf("ScheduledEvent: uri:%v ", e.URI))
+	b.WriteString(fmt.Sprintf("Name:%v ", e.Name))
+	b.WriteString(fmt.Sprintf("Status:%v ", e.Status))
+	b.WriteString(fmt.Sprintf("StartTime:%v ", e.StartTime))
+	b.WriteString(fmt.Sprintf("EndTime:%v ", e.EndTime))
+	b.WriteString(fmt.Sprintf("EventType:%v", e.EventType))
+
+	return b.String()
+}
+
+// List returns the scheduled events matching the options.
+// Either the User or the Organization option has to be set.
+func (s *ScheduledEventsService) List(ctx context.Context, opt *ScheduledEventsOpts) ([]*ScheduledEvent, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	u, err := addUrlOptions(scheduledEventsPath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	se := &scheduledEventListResponse{}
+	resp, err := s.client.Do(ctx, req, se)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return se.Collection, resp, nil
+}
+
+// Iter returns an Iterator over all the scheduled events matching the options.
+func (s *ScheduledEventsService) Iter(opt *ScheduledEventsOpts) *Iterator[*ScheduledEvent] {
+	o := ScheduledEventsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*ScheduledEvent, *Response, error) {
+		o.PageToken = pageToken
+		return s.List(ctx, &o)
+	})
+}
+
+// Get returns a single scheduled event by its UUID or URI.
+func (s *ScheduledEventsService) Get(ctx context.Context, uuidOrURI string) (*ScheduledEvent, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(scheduledEventsPath, uuidOrURI))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	se := &scheduledEventResponse{}
+	resp, err := s.client.Do(ctx, req, se)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return se.Resource, resp, nil
+}
@@ -0,0 +1,75 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestScheduledEventsService_List() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s", scheduledEventsPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		q := r.URL.Query()
+		assert.Equal("https://api.calendly.com/users/U1", q.Get("user"))
+		assert.Equal("active", q.Get("status"))
+		assert.Equal("2018-07-01T00:00:00Z", q.Get("min_start_time"))
+		assert.Equal("", q.Get("max_start_time"))
+		assert.Equal(SortStartTimeAsc, q.Get("sort"))
+		fmt.Fprint(w, `{"collection":[{"uri":"E1","start_time":"2018-07-02T10:00:00.000000Z",`+
+			`"end_time":"2018-07-02T10:30:00.000000Z","location":{"type":"zoom","join_url":"https://zoom"},`+
+			`"event_guests":[{"email":"guest@example.com"}]}],"pagination":{"count":1}}`)
+	})
+
+	events, _, err := suite.client.ScheduledEvents.List(context.Background(), &ScheduledEventsOpts{
+		User:         "https://api.calendly.com/users/U1",
+		Status:       EventStatusActive,
+		MinStartTime: time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC),
+		Sort:         SortStartTimeAsc,
+	})
+	assert.Nil(err)
+
+	want := []*ScheduledEvent{
+		{
+			URI:       "E1",
+			StartTime: time.Date(2018, 7, 2, 10, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2018, 7, 2, 10, 30, 0, 0, time.UTC),
+			Location:  &Location{Type: "zoom", JoinURL: "https://zoom"},
+			Guests:    []*EventGuest{{Email: "guest@example.com"}},
+		},
+	}
+	assert.Equal(want, events)
+}
+
+func (suite *CalendlyClientTestSuite) TestScheduledEventsService_Get() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	route := fmt.Sprintf("/%s/E1", scheduledEventsPath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/scheduled_events/E1","status":"canceled"}}`)
+	})
+
+	event, _, err := suite.client.ScheduledEvents.Get(context.Background(), "E1")
+	assert.Nil(err)
+
+	want := &ScheduledEvent{URI: "https://api.calendly.com/scheduled_events/E1", Status: EventStatusCanceled}
+	assert.Equal(want, event)
+}
+
+func (suite *CalendlyClientTestSuite) TestScheduledEventsService_V1Unsupported() {
+	assert := assert.New(suite.T())
+
+	_, _, err := suite.client.ScheduledEvents.List(context.Background(), &ScheduledEventsOpts{User: "U1"})
+	assert.Equal(errUnsupportedVersion, err)
+
+	_, _, err = suite.client.ScheduledEvents.Get(context.Background(), "E1")
+	assert.Equal(errUnsupportedVersion, err)
+}
@@ -0,0 +1,118 @@
+package calendly
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	schedulingLinksPath = "scheduling_links"
+
+	// Type of the resources single-use scheduling links can be created for
+	SchedulingLinkOwnerEventType = "EventType"
+)
+
+// SchedulingLinksAPI is the interface of the scheduling links service, implemented by SchedulingLinksService.
+type SchedulingLinksAPI interface {
+	Create(ctx context.Context, owner string, maxEventCount int) (*SchedulingLink, *Response, error)
+	CreateForEventType(ctx context.Context, et *EventType) (*SchedulingLink, *Response, error)
+}
+
+// SchedulingLinksService creates single-use scheduling links. API v2 only.
+type SchedulingLinksService apiService
+
+// SchedulingLink is a booking link that stops working once its maximum number of
+// events has been booked.
+type SchedulingLink struct {
+	// URL of the booking page to send to the invitee
+	BookingURL string `json:"booking_url"`
+
+	// URI of the event type the link books
+	Owner     string `json:"owner"`
+	OwnerType string `json:"owner_type"`
+}
+
+// InvalidOwnerError occurs when a scheduling link is requested for something that is
+// not an active API v2 event type. It matches ErrValidation.
+type InvalidOwnerError struct {
+	// Event type the link was requested for
+	EventType *EventType
+
+	Reason string
+}
+
+func (e *InvalidOwnerError) Is(target error) bool { return target == ErrValidation }
+
+func (e *InvalidOwnerError) Error() string {
+	return fmt.Sprintf("go-calendly: invalid scheduling link owner: %v", e.Reason)
+}
+
+type schedulingLinkRequest struct {
+	MaxEventCount int    `json:"max_event_count"`
+	Owner         string `json:"owner"`
+	OwnerType     string `json:"owner_type"`
+}
+
+type schedulingLinkResponse struct {
+	Resource *SchedulingLink `json:"resource"`
+}
+
+func (l *SchedulingLink) String() string {
+	return fmt.Sprintf("SchedulingLink: booking_url:%v Owner:%v", l.BookingURL, l.Owner)
+}
+
+// Create returns a new scheduling link for the event type identified by its URI, which can
+// be used to book up to maxEventCount events.
+func (s *SchedulingLinksService) Create(ctx context.Context, owner string, maxEventCount int) (*SchedulingLink, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Post(schedulingLinksPath, &schedulingLinkRequest{
+		MaxEventCount: maxEventCount,
+		Owner:         owner,
+		OwnerType:     SchedulingLinkOwnerEventType,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := &schedulingLinkResponse{}
+	resp, err := s.client.Do(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l.Resource, resp, nil
+}
+
+// CreateForEventType returns a new single-use scheduling link for an event type returned by
+// EventTypesService with API v2. An *InvalidOwnerError is returned without calling Calendly
+// when the event type cannot own a link.
+func (s *SchedulingLinksService) CreateForEventType(ctx context.Context, et *EventType) (*SchedulingLink, *Response, error) {
+	if err := validateLinkOwner(et); err != nil {
+		return nil, nil, err
+	}
+
+	return s.Create(ctx, et.ID, 1)
+}
+
+// validateLinkOwner checks that the event type is an active event type identified by its URI.
+func validateLinkOwner(et *EventType) error {
+	if et == nil {
+		return &InvalidOwnerError{Reason: "event type is nil"}
+	}
+
+	u, err := url.Parse(et.ID)
+	if err != nil || !u.IsAbs() || !strings.Contains(u.Path, "/"+eventTypesV2Path+"/") {
+		return &InvalidOwnerError{EventType: et, Reason: fmt.Sprintf("%q is not an API v2 event type URI", et.ID)}
+	}
+
+	if et.Attributes != nil && !et.Attributes.Active {
+		return &InvalidOwnerError{EventType: et, Reason: fmt.Sprintf("event type %v is not active", et.ID)}
+	}
+
+	return nil
+}
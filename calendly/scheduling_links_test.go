@@ -0,0 +1,78 @@
+package calendly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CalendlyClientTestSuite) TestSchedulingLinksService_Create() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+schedulingLinksPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodPost)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(`{"max_event_count":3,"owner":"https://api.calendly.com/event_types/E1","owner_type":"EventType"}`+"\n", string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resource":{"booking_url":"https://calendly.com/d/abc","owner":"https://api.calendly.com/event_types/E1",`+
+			`"owner_type":"EventType"}}`)
+	})
+
+	link, _, err := suite.client.SchedulingLinks.Create(context.Background(), "https://api.calendly.com/event_types/E1", 3)
+	assert.Nil(err)
+
+	want := &SchedulingLink{
+		BookingURL: "https://calendly.com/d/abc",
+		Owner:      "https://api.calendly.com/event_types/E1",
+		OwnerType:  SchedulingLinkOwnerEventType,
+	}
+	assert.Equal(want, link)
+}
+
+func (suite *CalendlyClientTestSuite) TestSchedulingLinksService_CreateForEventType() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+schedulingLinksPath, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(string(body), `"max_event_count":1`)
+		fmt.Fprint(w, `{"resource":{"booking_url":"https://calendly.com/d/abc"}}`)
+	})
+
+	et := &EventType{ID: "https://api.calendly.com/event_types/E1", Attributes: &EventTypeAttributes{Active: true}}
+	link, _, err := suite.client.SchedulingLinks.CreateForEventType(context.Background(), et)
+	assert.Nil(err)
+	assert.Equal("https://calendly.com/d/abc", link.BookingURL)
+}
+
+func (suite *CalendlyClientTestSuite) TestSchedulingLinksService_CreateForEventTypeInvalid() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	invalid := []*EventType{
+		nil,
+		{ID: "AAAAAAAAAAAAAAAA"},
+		{ID: "https://api.calendly.com/users/U1"},
+		{ID: "https://api.calendly.com/event_types/E1", Attributes: &EventTypeAttributes{Active: false}},
+	}
+	for _, et := range invalid {
+		_, _, err := suite.client.SchedulingLinks.CreateForEventType(context.Background(), et)
+
+		var ownerErr *InvalidOwnerError
+		assert.True(errors.As(err, &ownerErr))
+		assert.True(errors.Is(err, ErrValidation))
+	}
+}
+
+func (suite *CalendlyClientTestSuite) TestSchedulingLinksService_V1Unsupported() {
+	assert := assert.New(suite.T())
+
+	_, _, err := suite.client.SchedulingLinks.Create(context.Background(), "https://api.calendly.com/event_types/ET1", 1)
+	assert.Equal(errUnsupportedVersion, err)
+}
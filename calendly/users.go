@@ -1,49 +1,219 @@
 package calendly
 
 import (
-	"context"
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 )
 
 const (
 	aboutMePath = "users/me"
+	usersPath   = "users"
+
+	// Formats the times are displayed in to the user
+	TimeNotation12h TimeNotation = "12h"
+	TimeNotation24h TimeNotation = "24h"
 )
 
+// UsersAPI is the interface of the users service, implemented by UsersService.
+type UsersAPI interface {
+	AboutMe(ctx context.Context) (*AboutMe, *Response, error)
+	Get(ctx context.Context, uuidOrURI string) (*AboutMe, *Response, error)
+}
+
 type UsersService apiService
 
+// ErrNoUser is returned when Calendly answers a user request without the user.
+var ErrNoUser = errors.New("go-calendly: response does not contain a user")
+
 type AboutMeResponse struct {
 	AboutMe *AboutMe `json:"data"`
 }
 
 type AboutMe struct {
-	Type       string     `json:"type"`
-	ID         string     `json:"id"`
+	Type       string          `json:"type"`
+	ID         string          `json:"id"`
 	Attributes *UserAttributes `json:"attributes,omitempty"`
 }
 
+// Format the times are displayed in to the user
+type TimeNotation string
+
 type UserAttributes struct {
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	Email     string `json:"email"`
-	URL       string `json:"url"`
-	Timezone  string `json:"timezone"`
-	Avatar    *Avatar `json:"avatar,omitempty"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Email string `json:"email"`
+	URL   string `json:"url"`
+
+	// Timezone of the user, resolved with the timezone database of the system. Programs
+	// running where there is none should import time/tzdata to embed it in their binary.
+	Timezone  *time.Location `json:"timezone"`
+	Avatar    *Avatar        `json:"avatar,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+
+	// URL of the scheduling page of the user, the same as URL
+	SchedulingURL string `json:"scheduling_url,omitempty"`
+
+	// URI of the organization the user currently belongs to. Only returned by API v2.
+	CurrentOrganization string `json:"current_organization,omitempty"`
+
+	// Language and time format of the user. Only returned by API v2.
+	Locale       string       `json:"locale,omitempty"`
+	TimeNotation TimeNotation `json:"time_notation,omitempty"`
+
+	// IANA name of the timezone as returned by Calendly. It is kept when the name cannot
+	// be resolved, in which case Timezone is nil, and is used to encode a nil Timezone.
+	TimezoneName string `json:"-"`
+}
+
+// userAttributesJSON is the wire format of UserAttributes, which carries the timezone by name.
+type userAttributesJSON struct {
+	Name                string       `json:"name"`
+	Slug                string       `json:"slug"`
+	Email               string       `json:"email"`
+	URL                 string       `json:"url"`
+	Timezone            string       `json:"timezone"`
+	Avatar              *Avatar      `json:"avatar,omitempty"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+	SchedulingURL       string       `json:"scheduling_url,omitempty"`
+	CurrentOrganization string       `json:"current_organization,omitempty"`
+	Locale              string       `json:"locale,omitempty"`
+	TimeNotation        TimeNotation `json:"time_notation,omitempty"`
 }
 
 type Avatar struct {
 	URL string `json:"url"`
 }
 
-func (a *AboutMe) String() string  {
+// userV2 is the API v2 representation of a user.
+type userV2 struct {
+	URI                 string       `json:"uri"`
+	Name                string       `json:"name"`
+	Slug                string       `json:"slug"`
+	Email               string       `json:"email"`
+	SchedulingURL       string       `json:"scheduling_url"`
+	Timezone            string       `json:"timezone"`
+	AvatarURL           string       `json:"avatar_url"`
+	Locale              string       `json:"locale"`
+	TimeNotation        TimeNotation `json:"time_notation"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+	CurrentOrganization string       `json:"current_organization"`
+}
+
+type userV2Response struct {
+	Resource *userV2 `json:"resource"`
+}
+
+func (u *userV2) toAboutMe() *AboutMe {
+	if u == nil {
+		return nil
+	}
+
+	a := &AboutMe{
+		Type: "users",
+		ID:   u.URI,
+		Attributes: &UserAttributes{
+			Name:                u.Name,
+			Slug:                u.Slug,
+			Email:               u.Email,
+			URL:                 u.SchedulingURL,
+			SchedulingURL:       u.SchedulingURL,
+			Timezone:            loadLocation(u.Timezone),
+			TimezoneName:        u.Timezone,
+			CreatedAt:           u.CreatedAt,
+			UpdatedAt:           u.UpdatedAt,
+			CurrentOrganization: u.CurrentOrganization,
+			Locale:              u.Locale,
+			TimeNotation:        u.TimeNotation,
+		},
+	}
+	if u.AvatarURL != "" {
+		a.Attributes.Avatar = &Avatar{URL: u.AvatarURL}
+	}
+
+	return a
+}
+
+func (u *UserAttributes) UnmarshalJSON(data []byte) error {
+	aux := &userAttributesJSON{}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	*u = UserAttributes{
+		Name:                aux.Name,
+		Slug:                aux.Slug,
+		Email:               aux.Email,
+		URL:                 aux.URL,
+		Timezone:            loadLocation(aux.Timezone),
+		TimezoneName:        aux.Timezone,
+		Avatar:              aux.Avatar,
+		CreatedAt:           aux.CreatedAt,
+		UpdatedAt:           aux.UpdatedAt,
+		SchedulingURL:       aux.SchedulingURL,
+		CurrentOrganization: aux.CurrentOrganization,
+		Locale:              aux.Locale,
+		TimeNotation:        aux.TimeNotation,
+	}
+	if u.SchedulingURL == "" {
+		u.SchedulingURL = u.URL
+	}
+
+	return nil
+}
+
+func (u UserAttributes) MarshalJSON() ([]byte, error) {
+	aux := userAttributesJSON{
+		Name:                u.Name,
+		Slug:                u.Slug,
+		Email:               u.Email,
+		URL:                 u.URL,
+		Timezone:            u.TimezoneName,
+		Avatar:              u.Avatar,
+		CreatedAt:           u.CreatedAt,
+		UpdatedAt:           u.UpdatedAt,
+		SchedulingURL:       u.SchedulingURL,
+		CurrentOrganization: u.CurrentOrganization,
+		Locale:              u.Locale,
+		TimeNotation:        u.TimeNotation,
+	}
+	if u.Timezone != nil {
+		aux.Timezone = u.Timezone.String()
+	}
+
+	return json.Marshal(aux)
+}
+
+// loadLocation returns the location of an IANA timezone name, or nil when the name is
+// empty or unknown to the timezone database.
+func loadLocation(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+
+	return loc
+}
+
+func (a *AboutMe) String() string {
 	b := bytes.NewBufferString("")
 	b.WriteString(fmt.Sprintf("About Me: id:%v attributes: ", a.ID))
 	b.WriteString(fmt.Sprintf("Name:%v ", a.Attributes.Name))
 	b.WriteString(fmt.Sprintf("Email:%v ", a.Attributes.Email))
 	b.WriteString(fmt.Sprintf("Slug:%v ", a.Attributes.Slug))
-	b.WriteString(fmt.Sprintf("Timezone:%v ", a.Attributes.Timezone))
+	if a.Attributes.Timezone != nil {
+		b.WriteString(fmt.Sprintf("Timezone:%v ", a.Attributes.Timezone))
+	}
 	b.WriteString(fmt.Sprintf("CreatedAt:%v ", a.Attributes.CreatedAt))
 	b.WriteString(fmt.Sprintf("UpdatedAt:%v ", a.Attributes.UpdatedAt))
 
@@ -57,17 +227,59 @@ func (a *AboutMe) String() string  {
 
 // Use this endpoint to request basic information about yourself.
 // This might be helpful if you're building functionality for multiple Calendly users.
-func (s *UsersService)AboutMe(ctx context.Context) (*AboutMe, *Response, error)  {
+//
+// With API v2 the ID of the returned user is its resource URI.
+func (s *UsersService) AboutMe(ctx context.Context) (*AboutMe, *Response, error) {
 	req, err := s.client.Get(aboutMePath)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if s.client.version == APIV2 {
+		u := &userV2Response{}
+		resp, err := s.client.Do(ctx, req, u)
+		if err != nil {
+			return nil, resp, err
+		}
+		if u.Resource == nil {
+			return nil, resp, ErrNoUser
+		}
+
+		return u.Resource.toAboutMe(), resp, nil
+	}
+
 	a := &AboutMeResponse{}
 	resp, err := s.client.Do(ctx, req, a)
 	if err != nil {
 		return nil, resp, err
 	}
+	if a.AboutMe == nil {
+		return nil, resp, ErrNoUser
+	}
 
 	return a.AboutMe, resp, nil
 }
+
+// Get returns a single user by its UUID or URI, e.g. the owner referenced by the
+// Relationships of an event type. API v2 only.
+func (s *UsersService) Get(ctx context.Context, uuidOrURI string) (*AboutMe, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(usersPath, uuidOrURI))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := &userV2Response{}
+	resp, err := s.client.Do(ctx, req, u)
+	if err != nil {
+		return nil, resp, err
+	}
+	if u.Resource == nil {
+		return nil, resp, ErrNoUser
+	}
+
+	return u.Resource.toAboutMe(), resp, nil
+}
@@ -1,10 +1,13 @@
 package calendly
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/stretchr/testify/assert"
 	"net/http"
-	"context"
+	"time"
 )
 
 func (suite *CalendlyClientTestSuite) TestUsersService_AboutMe() {
@@ -22,3 +25,123 @@ func (suite *CalendlyClientTestSuite) TestUsersService_AboutMe() {
 	want := &AboutMe{ID: "123"}
 	assert.Equal(want, me)
 }
+
+func (suite *CalendlyClientTestSuite) TestUsersService_AboutMeV2() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+aboutMePath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/users/U1","name":"Me",`+
+			`"scheduling_url":"https://calendly.com/me","avatar_url":"https://avatar"}}`)
+	})
+
+	me, _, err := suite.client.Users.AboutMe(context.Background())
+	assert.Nil(err)
+
+	want := &AboutMe{
+		Type: "users",
+		ID:   "https://api.calendly.com/users/U1",
+		Attributes: &UserAttributes{
+			Name:          "Me",
+			URL:           "https://calendly.com/me",
+			SchedulingURL: "https://calendly.com/me",
+			Avatar:        &Avatar{URL: "https://avatar"},
+		},
+	}
+	assert.Equal(want, me)
+}
+
+func (suite *CalendlyClientTestSuite) TestUsersService_AboutMeAttributes() {
+	assert := assert.New(suite.T())
+	route := fmt.Sprintf("/%s", aboutMePath)
+
+	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"id":"123","attributes":{"url":"https://calendly.com/me","timezone":"Europe/Athens",`+
+			`"created_at":"2018-01-02T03:04:05Z","updated_at":"2018-02-03T04:05:06Z"}}}`)
+	})
+
+	me, _, err := suite.client.Users.AboutMe(context.Background())
+	assert.Nil(err)
+
+	attrs := me.Attributes
+	assert.Equal("Europe/Athens", attrs.Timezone.String())
+	assert.Equal(time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC), attrs.CreatedAt)
+	assert.Equal(time.Date(2018, 2, 3, 4, 5, 6, 0, time.UTC), attrs.UpdatedAt)
+	assert.Equal("https://calendly.com/me", attrs.SchedulingURL)
+}
+
+func (suite *CalendlyClientTestSuite) TestUsersService_UnknownTimezone() {
+	assert := assert.New(suite.T())
+
+	attrs := &UserAttributes{}
+	assert.Nil(json.Unmarshal([]byte(`{"timezone":"Mars/Olympus_Mons"}`), attrs))
+	assert.Nil(attrs.Timezone)
+	assert.Equal("Mars/Olympus_Mons", attrs.TimezoneName)
+
+	data, err := json.Marshal(attrs)
+	assert.Nil(err)
+	assert.Contains(string(data), `"timezone":"Mars/Olympus_Mons"`)
+}
+
+func (suite *CalendlyClientTestSuite) TestUsersService_Get() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+usersPath+"/U2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/users/U2","name":"Jane","timezone":"America/New_York",`+
+			`"locale":"en","time_notation":"24h","created_at":"2020-01-02T03:04:05.678Z",`+
+			`"current_organization":"https://api.calendly.com/organizations/O1"}}`)
+	})
+
+	user, _, err := suite.client.Users.Get(context.Background(), "https://api.calendly.com/users/U2")
+	assert.Nil(err)
+
+	assert.Equal("https://api.calendly.com/users/U2", user.ID)
+	assert.Equal("Jane", user.Attributes.Name)
+	assert.Equal("America/New_York", user.Attributes.Timezone.String())
+	assert.Equal("en", user.Attributes.Locale)
+	assert.Equal(TimeNotation24h, user.Attributes.TimeNotation)
+	assert.Equal("https://api.calendly.com/organizations/O1", user.Attributes.CurrentOrganization)
+	assert.Equal(time.Date(2020, 1, 2, 3, 4, 5, 678000000, time.UTC), user.Attributes.CreatedAt)
+}
+
+func (suite *CalendlyClientTestSuite) TestUsersService_GetV1() {
+	assert := assert.New(suite.T())
+
+	_, _, err := suite.client.Users.Get(context.Background(), "U2")
+	assert.Equal(errUnsupportedVersion, err)
+}
+
+func (suite *CalendlyClientTestSuite) TestUsersService_EmptyResource() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+aboutMePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resource":null}`)
+	})
+	suite.mux.HandleFunc("/"+usersPath+"/U2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resource":null}`)
+	})
+
+	me, _, err := suite.client.Users.AboutMe(context.Background())
+	assert.Nil(me)
+	assert.True(errors.Is(err, ErrNoUser))
+
+	user, _, err := suite.client.Users.Get(context.Background(), "U2")
+	assert.Nil(user)
+	assert.True(errors.Is(err, ErrNoUser))
+}
+
+func (suite *CalendlyClientTestSuite) TestUsersService_AboutMeEmpty() {
+	assert := assert.New(suite.T())
+
+	suite.mux.HandleFunc("/"+aboutMePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	me, _, err := suite.client.Users.AboutMe(context.Background())
+	assert.Nil(me)
+	assert.True(errors.Is(err, ErrNoUser))
+}
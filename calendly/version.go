@@ -0,0 +1,49 @@
+package calendly
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const (
+	defaultV2BaseURL = "https://api.calendly.com/"
+)
+
+// APIVersion selects the generation of the Calendly API a Client talks to.
+type APIVersion string
+
+const (
+	// APIV1 is the legacy https://calendly.com/api/v1 API authenticated with an X-Token header.
+	APIV1 APIVersion = "v1"
+
+	// APIV2 is the https://api.calendly.com API. Resources are identified by their URI
+	// and requests are authenticated with a bearer token.
+	APIV2 APIVersion = "v2"
+)
+
+var errUnsupportedVersion = errors.New("go-calendly: method is not supported by the selected API version")
+
+// UUIDFromURI returns the trailing identifier of an API v2 resource URI,
+// e.g. "AAAA" for "https://api.calendly.com/users/AAAA".
+// Values that are not URIs are returned unchanged.
+func UUIDFromURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() {
+		return uri
+	}
+
+	p := strings.TrimSuffix(u.Path, "/")
+	return p[strings.LastIndex(p, "/")+1:]
+}
+
+// resourcePath returns the request path of a single API v2 resource. uuidOrURI may either be a bare
+// UUID, which is appended to collection, or a full resource URI whose path is resolved against
+// the base URL of the client, so that URIs keep working with a custom base URL.
+func resourcePath(collection, uuidOrURI string) string {
+	if u, err := url.Parse(uuidOrURI); err == nil && u.IsAbs() {
+		return strings.TrimPrefix(u.EscapedPath(), "/")
+	}
+
+	return collection + "/" + url.PathEscape(uuidOrURI)
+}
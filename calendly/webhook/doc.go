@@ -0,0 +1,12 @@
+/*
+Package webhook receives the webhook deliveries of Calendly.
+
+It decodes the invitee.created and invitee.canceled payloads of both the v1 hooks and
+the API v2 webhook subscriptions into typed events, and provides an http.Handler
+dispatching them to registered callbacks. Signed deliveries can be verified with a Verifier.
+
+A Simulator sends signed deliveries generated from a Template to a receiver, to test it
+without booking real events.
+*/
+
+package webhook
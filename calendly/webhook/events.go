@@ -0,0 +1,247 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+// ErrUnknownEvent is returned by Parse for deliveries of events it has no type for.
+var ErrUnknownEvent = errors.New("go-calendly: unknown webhook event")
+
+// Envelope holds the fields common to every webhook delivery.
+type Envelope struct {
+	// Kind of the delivered event
+	Event calendly.EventHookType `json:"event"`
+
+	// Time the event was triggered
+	CreatedAt time.Time `json:"created_at"`
+
+	// URI of the user that triggered the event. Only sent by API v2 subscriptions.
+	CreatedBy string `json:"created_by,omitempty"`
+}
+
+// InviteeCreatedEvent is delivered when an invitee books an event.
+type InviteeCreatedEvent struct {
+	Envelope
+	Payload *InviteePayload `json:"payload"`
+}
+
+// InviteeCancelledEvent is delivered when an invitee cancels an event, or the host cancels it.
+type InviteeCancelledEvent struct {
+	Envelope
+	Payload *InviteePayload `json:"payload"`
+}
+
+// CancellationReason returns the reason given for the cancellation.
+func (e *InviteeCancelledEvent) CancellationReason() string {
+	if e.Payload == nil || e.Payload.Cancellation == nil {
+		return ""
+	}
+
+	return e.Payload.Cancellation.Reason
+}
+
+// InviteePayload is the payload of the invitee events. It is decoded from both the v1 hooks
+// and the API v2 webhook subscriptions payload formats.
+// It is encoded in the API v2 format.
+type InviteePayload struct {
+	// The scheduled event the invitee booked
+	Event *calendly.ScheduledEvent
+
+	Invitee             *calendly.Invitee
+	QuestionsAndAnswers []*calendly.QuestionAndAnswer
+	Tracking            *calendly.Tracking
+
+	// Set on invitee.canceled deliveries
+	Cancellation *calendly.Cancellation
+}
+
+// payloadV2 is the API v2 payload, the invitee resource with its scheduled event embedded.
+type payloadV2 struct {
+	calendly.Invitee
+	ScheduledEvent *calendly.ScheduledEvent `json:"scheduled_event"`
+}
+
+// payloadV1 is the payload of the v1 hooks.
+type payloadV1 struct {
+	EventType *struct {
+		UUID string `json:"uuid"`
+		Name string `json:"name"`
+	} `json:"event_type"`
+	Event *struct {
+		UUID      string    `json:"uuid"`
+		StartTime time.Time `json:"start_time"`
+		EndTime   time.Time `json:"end_time"`
+		Location  string    `json:"location"`
+		CreatedAt time.Time `json:"created_at"`
+	} `json:"event"`
+	Invitee *struct {
+		UUID               string    `json:"uuid"`
+		Name               string    `json:"name"`
+		FirstName          string    `json:"first_name"`
+		LastName           string    `json:"last_name"`
+		Email              string    `json:"email"`
+		Timezone           string    `json:"timezone"`
+		TextReminderNumber string    `json:"text_reminder_number"`
+		IsReschedule       bool      `json:"is_reschedule"`
+		Canceled           bool      `json:"canceled"`
+		CancelerName       string    `json:"canceler_name"`
+		CancelReason       string    `json:"cancel_reason"`
+		CanceledAt         time.Time `json:"canceled_at"`
+		CreatedAt          time.Time `json:"created_at"`
+	} `json:"invitee"`
+	QuestionsAndAnswers []*calendly.QuestionAndAnswer `json:"questions_and_answers"`
+	Tracking            *calendly.Tracking            `json:"tracking"`
+}
+
+func (p *InviteePayload) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Invitee json.RawMessage `json:"invitee"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	if probe.Invitee != nil {
+		v1 := &payloadV1{}
+		if err := json.Unmarshal(data, v1); err != nil {
+			return err
+		}
+
+		*p = v1.toPayload()
+		return nil
+	}
+
+	v2 := &payloadV2{}
+	if err := json.Unmarshal(data, v2); err != nil {
+		return err
+	}
+
+	*p = v2.toPayload()
+	return nil
+}
+
+func (p InviteePayload) MarshalJSON() ([]byte, error) {
+	v2 := payloadV2{ScheduledEvent: p.Event}
+	if p.Invitee != nil {
+		v2.Invitee = *p.Invitee
+	}
+	v2.QuestionsAndAnswers = p.QuestionsAndAnswers
+	v2.Tracking = p.Tracking
+	v2.Cancellation = p.Cancellation
+
+	return json.Marshal(v2)
+}
+
+func (v2 *payloadV2) toPayload() InviteePayload {
+	invitee := v2.Invitee
+	return InviteePayload{
+		Event:               v2.ScheduledEvent,
+		Invitee:             &invitee,
+		QuestionsAndAnswers: invitee.QuestionsAndAnswers,
+		Tracking:            invitee.Tracking,
+		Cancellation:        invitee.Cancellation,
+	}
+}
+
+func (v1 *payloadV1) toPayload() InviteePayload {
+	p := InviteePayload{
+		QuestionsAndAnswers: v1.QuestionsAndAnswers,
+		Tracking:            v1.Tracking,
+	}
+
+	if v1.Event != nil {
+		p.Event = &calendly.ScheduledEvent{
+			URI:       v1.Event.UUID,
+			StartTime: v1.Event.StartTime,
+			EndTime:   v1.Event.EndTime,
+			CreatedAt: v1.Event.CreatedAt,
+			Status:    calendly.EventStatusActive,
+		}
+		if v1.Event.Location != "" {
+			p.Event.Location = &calendly.Location{Location: v1.Event.Location}
+		}
+		if v1.EventType != nil {
+			p.Event.Name = v1.EventType.Name
+			p.Event.EventType = v1.EventType.UUID
+		}
+	}
+
+	if i := v1.Invitee; i != nil {
+		p.Invitee = &calendly.Invitee{
+			URI:                 i.UUID,
+			Name:                i.Name,
+			FirstName:           i.FirstName,
+			LastName:            i.LastName,
+			Email:               i.Email,
+			Timezone:            i.Timezone,
+			TextReminderNumber:  i.TextReminderNumber,
+			Rescheduled:         i.IsReschedule,
+			Status:              calendly.InviteeStatusActive,
+			QuestionsAndAnswers: v1.QuestionsAndAnswers,
+			Tracking:            v1.Tracking,
+			CreatedAt:           i.CreatedAt,
+		}
+		if p.Event != nil {
+			p.Invitee.Event = p.Event.URI
+		}
+
+		if i.Canceled {
+			p.Invitee.Status = calendly.InviteeStatusCanceled
+			p.Cancellation = &calendly.Cancellation{
+				CanceledBy: i.CancelerName,
+				Reason:     i.CancelReason,
+				CreatedAt:  i.CanceledAt,
+			}
+			p.Invitee.Cancellation = p.Cancellation
+		}
+	}
+
+	return p
+}
+
+// envelopeV1 holds the trigger time of v1 deliveries, which is sent as "time".
+type envelopeV1 struct {
+	Time time.Time `json:"time"`
+}
+
+// Parse decodes the body of a webhook delivery into an *InviteeCreatedEvent or
+// an *InviteeCancelledEvent, depending on its event kind.
+func Parse(body []byte) (interface{}, error) {
+	env := &Envelope{}
+	if err := json.Unmarshal(body, env); err != nil {
+		return nil, fmt.Errorf("go-calendly: malformed webhook payload: %v", err)
+	}
+
+	var event interface{}
+	switch env.Event {
+	case calendly.InviteeCreatedHookType:
+		event = &InviteeCreatedEvent{}
+	case calendly.InviteeCancelledHookType, calendly.InviteeCanceledHookType:
+		event = &InviteeCancelledEvent{}
+	default:
+		return nil, ErrUnknownEvent
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("go-calendly: malformed webhook payload: %v", err)
+	}
+
+	if env.CreatedAt.IsZero() {
+		v1 := &envelopeV1{}
+		if err := json.Unmarshal(body, v1); err == nil {
+			switch e := event.(type) {
+			case *InviteeCreatedEvent:
+				e.CreatedAt = v1.Time
+			case *InviteeCancelledEvent:
+				e.CreatedAt = v1.Time
+			}
+		}
+	}
+
+	return event, nil
+}
@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+const inviteeCreatedV2 = `{
+  "created_at": "2020-11-23T17:51:19.000000Z",
+  "created_by": "https://api.calendly.com/users/U1",
+  "event": "invitee.created",
+  "payload": {
+    "uri": "https://api.calendly.com/scheduled_events/E1/invitees/I1",
+    "email": "test@example.com",
+    "name": "John Doe",
+    "status": "active",
+    "event": "https://api.calendly.com/scheduled_events/E1",
+    "questions_and_answers": [{"question": "Company?", "answer": "ACME", "position": 0}],
+    "tracking": {"utm_campaign": "spring"},
+    "scheduled_event": {
+      "uri": "https://api.calendly.com/scheduled_events/E1",
+      "name": "15 Minute Meeting",
+      "start_time": "2020-11-25T17:00:00.000000Z",
+      "end_time": "2020-11-25T17:15:00.000000Z"
+    }
+  }
+}`
+
+const inviteeCanceledV1 = `{
+  "event": "invitee.canceled",
+  "time": "2018-03-14T19:16:01Z",
+  "payload": {
+    "event_type": {"uuid": "ET1", "name": "15 Minute Meeting"},
+    "event": {"uuid": "E1", "start_time": "2018-03-14T12:00:00Z", "end_time": "2018-03-14T12:15:00Z"},
+    "invitee": {"uuid": "I1", "email": "test@example.com", "canceled": true,
+      "canceler_name": "John Doe", "cancel_reason": "Conflict"},
+    "questions_and_answers": [{"question": "Company?", "answer": "ACME"}],
+    "tracking": {"utm_source": "newsletter"}
+  }
+}`
+
+func TestParse_InviteeCreatedV2(t *testing.T) {
+	assert := assert.New(t)
+
+	event, err := Parse([]byte(inviteeCreatedV2))
+	assert.Nil(err)
+
+	created, ok := event.(*InviteeCreatedEvent)
+	assert.True(ok)
+	assert.Equal(calendly.InviteeCreatedHookType, created.Event)
+	assert.Equal(time.Date(2020, 11, 23, 17, 51, 19, 0, time.UTC), created.CreatedAt)
+	assert.Equal("https://api.calendly.com/users/U1", created.CreatedBy)
+	assert.Equal("test@example.com", created.Payload.Invitee.Email)
+	assert.Equal("15 Minute Meeting", created.Payload.Event.Name)
+	assert.Equal(time.Date(2020, 11, 25, 17, 0, 0, 0, time.UTC), created.Payload.Event.StartTime)
+	assert.Equal([]*calendly.QuestionAndAnswer{{Question: "Company?", Answer: "ACME"}}, created.Payload.QuestionsAndAnswers)
+	assert.Equal(&calendly.Tracking{UTMCampaign: "spring"}, created.Payload.Tracking)
+}
+
+func TestParse_InviteeCanceledV1(t *testing.T) {
+	assert := assert.New(t)
+
+	event, err := Parse([]byte(inviteeCanceledV1))
+	assert.Nil(err)
+
+	cancelled, ok := event.(*InviteeCancelledEvent)
+	assert.True(ok)
+	assert.Equal(time.Date(2018, 3, 14, 19, 16, 1, 0, time.UTC), cancelled.CreatedAt)
+	assert.Equal("Conflict", cancelled.CancellationReason())
+	assert.Equal("John Doe", cancelled.Payload.Cancellation.CanceledBy)
+	assert.Equal(calendly.InviteeStatusCanceled, cancelled.Payload.Invitee.Status)
+	assert.Equal("E1", cancelled.Payload.Invitee.Event)
+	assert.Equal("15 Minute Meeting", cancelled.Payload.Event.Name)
+	assert.Equal(&calendly.Tracking{UTMSource: "newsletter"}, cancelled.Payload.Tracking)
+}
+
+func TestParse_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := Parse([]byte(`{"event":"routing_form_submission.created","payload":{}}`))
+	assert.Equal(ErrUnknownEvent, err)
+
+	_, err = Parse([]byte(`{"event":`))
+	assert.NotNil(err)
+
+	_, err = Parse([]byte(`{"event":"invitee.created","payload":{"uri":1}}`))
+	assert.NotNil(err)
+}
+
+func TestInviteePayload_RoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	event, _ := Parse([]byte(inviteeCreatedV2))
+	body, err := json.Marshal(event)
+	assert.Nil(err)
+
+	again, err := Parse(body)
+	assert.Nil(err)
+	assert.Equal(event, again)
+}
@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// DefaultMaxBodyBytes is the largest delivery body a Handler accepts by default.
+const DefaultMaxBodyBytes = 1 << 20
+
+// Handler is an http.Handler receiving Calendly webhook deliveries. It parses every delivery
+// into its typed event and dispatches it to the callbacks registered for that event:
+//
+//	h := webhook.NewHandler()
+//	h.OnInviteeCreated(func(ctx context.Context, e *webhook.InviteeCreatedEvent) error {
+//		log.Println("booked by", e.Payload.Invitee.Email)
+//		return nil
+//	})
+//	http.Handle("/calendly", h)
+//
+// Deliveries are acknowledged with 200 OK once all callbacks succeeded. A failing callback
+// answers 500 Internal Server Error so that Calendly delivers the event again. Deliveries of
+// events without a registered callback are acknowledged and dropped.
+type Handler struct {
+	// Largest accepted body, DefaultMaxBodyBytes when zero
+	MaxBodyBytes int64
+
+	mu        sync.RWMutex
+	created   []func(context.Context, *InviteeCreatedEvent) error
+	cancelled []func(context.Context, *InviteeCancelledEvent) error
+}
+
+// NewHandler returns a Handler without callbacks.
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// OnInviteeCreated registers a callback for invitee.created deliveries.
+func (h *Handler) OnInviteeCreated(fn func(context.Context, *InviteeCreatedEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.created = append(h.created, fn)
+}
+
+// OnInviteeCancelled registers a callback for invitee cancellation deliveries.
+func (h *Handler) OnInviteeCancelled(fn func(context.Context, *InviteeCancelledEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.cancelled = append(h.cancelled, fn)
+}
+
+// maxBodyBytes returns the given body size limit, DefaultMaxBodyBytes when it is not positive.
+func maxBodyBytes(max int64) int64 {
+	if max <= 0 {
+		return DefaultMaxBodyBytes
+	}
+
+	return max
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes(h.MaxBodyBytes)))
+	if err != nil {
+		http.Error(w, "cannot read webhook payload", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	event, err := Parse(body)
+	if err == ErrUnknownEvent {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls the callbacks registered for the event, stopping at the first error.
+func (h *Handler) dispatch(ctx context.Context, event interface{}) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	switch e := event.(type) {
+	case *InviteeCreatedEvent:
+		for _, fn := range h.created {
+			if err := fn(ctx, e); err != nil {
+				return err
+			}
+		}
+	case *InviteeCancelledEvent:
+		for _, fn := range h.cancelled {
+			if err := fn(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
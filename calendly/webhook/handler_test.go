@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_Dispatch(t *testing.T) {
+	assert := assert.New(t)
+
+	var created []*InviteeCreatedEvent
+	var cancelled []*InviteeCancelledEvent
+	h := NewHandler()
+	h.OnInviteeCreated(func(ctx context.Context, e *InviteeCreatedEvent) error {
+		created = append(created, e)
+		return nil
+	})
+	h.OnInviteeCancelled(func(ctx context.Context, e *InviteeCancelledEvent) error {
+		cancelled = append(cancelled, e)
+		return nil
+	})
+
+	for _, body := range []string{inviteeCreatedV2, inviteeCanceledV1} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		assert.Equal(http.StatusOK, rec.Code)
+	}
+
+	assert.Len(created, 1)
+	assert.Len(cancelled, 1)
+	assert.Equal("test@example.com", created[0].Payload.Invitee.Email)
+	assert.Equal("Conflict", cancelled[0].CancellationReason())
+}
+
+func TestHandler_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	h := NewHandler()
+	h.OnInviteeCreated(func(ctx context.Context, e *InviteeCreatedEvent) error {
+		return errors.New("database is down")
+	})
+
+	testCases := []struct {
+		name   string
+		method string
+		body   string
+		want   int
+	}{
+		{"TestMethodNotAllowed", http.MethodGet, "", http.StatusMethodNotAllowed},
+		{"TestMalformedPayload", http.MethodPost, `{"event":`, http.StatusBadRequest},
+		{"TestUnknownEvent", http.MethodPost, `{"event":"invitee_no_show.created"}`, http.StatusOK},
+		{"TestCallbackError", http.MethodPost, inviteeCreatedV2, http.StatusInternalServerError},
+		{"TestNoCallback", http.MethodPost, inviteeCanceledV1, http.StatusOK},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tc.method, "/", strings.NewReader(tc.body)))
+			assert.Equal(tc.want, rec.Code)
+		})
+	}
+}
+
+func TestHandler_MaxBodyBytes(t *testing.T) {
+	h := &Handler{MaxBodyBytes: 10}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(inviteeCreatedV2)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
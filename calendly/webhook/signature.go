@@ -0,0 +1,174 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header carrying the signature of a delivery, formatted as "t=<unix time>,v1=<hex hmac>".
+	SignatureHeader = "Calendly-Webhook-Signature"
+
+	// DefaultTolerance is how old a signature may be before it is rejected as a replay.
+	DefaultTolerance = 3 * time.Minute
+)
+
+var (
+	ErrMissingSignature   = errors.New("go-calendly: webhook signature is missing")
+	ErrMalformedSignature = errors.New("go-calendly: webhook signature is malformed")
+	ErrExpiredSignature   = errors.New("go-calendly: webhook signature timestamp is outside the tolerance")
+	ErrSignatureMismatch  = errors.New("go-calendly: webhook signature does not match")
+	ErrNoSigningKey       = errors.New("go-calendly: webhook signing key is empty")
+)
+
+// Verifier checks the signatures Calendly attaches to the deliveries of webhook
+// subscriptions created with a signing key.
+type Verifier struct {
+	// Signing key of the webhook subscription
+	SigningKey string
+
+	// Maximum age of a signature, DefaultTolerance when zero. Deliveries signed further
+	// in the past or in the future are rejected to prevent replays.
+	Tolerance time.Duration
+
+	// Largest body read by Middleware. When zero, the MaxBodyBytes of the Handler wrapped
+	// by Middleware is used, DefaultMaxBodyBytes otherwise.
+	MaxBodyBytes int64
+
+	// now returns the current time, time.Now when nil
+	now func() time.Time
+}
+
+// NewVerifier returns a Verifier for the given signing key using DefaultTolerance.
+func NewVerifier(signingKey string) *Verifier {
+	return &Verifier{SigningKey: signingKey}
+}
+
+// Sign returns the signature header value for a body delivered at time t.
+func Sign(signingKey string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return fmt.Sprintf("t=%v,v1=%v", ts, hex.EncodeToString(computeSignature(signingKey, ts, body)))
+}
+
+// Verify checks the signature header of a delivery against its body. ErrNoSigningKey is
+// returned when the Verifier has no signing key, as anyone could forge a signature with an empty key.
+func (v *Verifier) Verify(header string, body []byte) error {
+	if v.SigningKey == "" {
+		return ErrNoSigningKey
+	}
+	if header == "" {
+		return ErrMissingSignature
+	}
+
+	ts, signatures, err := parseSignature(header)
+	if err != nil {
+		return err
+	}
+
+	secs, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrMalformedSignature
+	}
+
+	tolerance := v.Tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+
+	now := time.Now
+	if v.now != nil {
+		now = v.now
+	}
+
+	age := now().Sub(time.Unix(secs, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrExpiredSignature
+	}
+
+	expected := computeSignature(v.SigningKey, ts, body)
+	for _, sig := range signatures {
+		if hmac.Equal(expected, sig) {
+			return nil
+		}
+	}
+
+	return ErrSignatureMismatch
+}
+
+// Middleware returns a handler verifying the signature of every delivery before passing it
+// to next, typically a Handler. Deliveries failing verification are answered with 401 Unauthorized,
+// and every delivery is answered with 500 Internal Server Error when the Verifier has no signing key.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	max := v.MaxBodyBytes
+	if h, ok := next.(*Handler); ok && max <= 0 {
+		max = h.MaxBodyBytes
+	}
+	max = maxBodyBytes(max)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, max))
+		if err != nil {
+			http.Error(w, "cannot read webhook payload", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if err := v.Verify(r.Header.Get(SignatureHeader), body); err == ErrNoSigningKey {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseSignature splits the signature header into its timestamp and v1 signatures.
+func parseSignature(header string) (string, [][]byte, error) {
+	var ts string
+	var signatures [][]byte
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			return "", nil, ErrMalformedSignature
+		}
+
+		switch kv[0] {
+		case "t":
+			ts = kv[1]
+		case "v1":
+			sig, err := hex.DecodeString(kv[1])
+			if err != nil {
+				return "", nil, ErrMalformedSignature
+			}
+			signatures = append(signatures, sig)
+		}
+	}
+
+	if ts == "" || len(signatures) == 0 {
+		return "", nil, ErrMalformedSignature
+	}
+
+	return ts, signatures, nil
+}
+
+// computeSignature is the HMAC-SHA256 of "<timestamp>.<body>" keyed with the signing key.
+func computeSignature(signingKey, ts string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSigningKey = "secret"
+
+func TestVerifier_Verify(t *testing.T) {
+	now := time.Unix(1492774577, 0)
+	body := []byte(inviteeCreatedV2)
+	v := NewVerifier(testSigningKey)
+	v.now = func() time.Time { return now }
+
+	valid := Sign(testSigningKey, now.Add(-time.Minute), body)
+
+	testCases := []struct {
+		name   string
+		header string
+		body   []byte
+		want   error
+	}{
+		{"TestValid", valid, body, nil},
+		{"TestRotatedKeys", valid + ",v1=" + strings.Repeat("00", 32), body, nil},
+		{"TestMissing", "", body, ErrMissingSignature},
+		{"TestNoTimestamp", "v1=abcd", body, ErrMalformedSignature},
+		{"TestNoSignature", "t=1492774577", body, ErrMalformedSignature},
+		{"TestNotHex", "t=1492774577,v1=xyz", body, ErrMalformedSignature},
+		{"TestBadTimestamp", "t=now,v1=abcd", body, ErrMalformedSignature},
+		{"TestGarbage", "garbage", body, ErrMalformedSignature},
+		{"TestExpired", Sign(testSigningKey, now.Add(-time.Hour), body), body, ErrExpiredSignature},
+		{"TestFuture", Sign(testSigningKey, now.Add(time.Hour), body), body, ErrExpiredSignature},
+		{"TestTamperedBody", valid, []byte(`{}`), ErrSignatureMismatch},
+		{"TestWrongKey", Sign("other", now, body), body, ErrSignatureMismatch},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, v.Verify(tc.header, tc.body))
+		})
+	}
+}
+
+func TestVerifier_NoSigningKey(t *testing.T) {
+	assert := assert.New(t)
+
+	body := []byte(inviteeCreatedV2)
+	v := NewVerifier("")
+	assert.Equal(ErrNoSigningKey, v.Verify(Sign("", time.Now(), body), body))
+
+	called := 0
+	srv := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(inviteeCreatedV2))
+	req.Header.Set(SignatureHeader, Sign("", time.Now(), body))
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	assert.Equal(http.StatusInternalServerError, rec.Code)
+	assert.Equal(0, called)
+}
+
+func TestVerifier_Middleware(t *testing.T) {
+	assert := assert.New(t)
+
+	called := 0
+	h := NewHandler()
+	h.OnInviteeCreated(func(ctx context.Context, e *InviteeCreatedEvent) error {
+		called++
+		return nil
+	})
+	srv := NewVerifier(testSigningKey).Middleware(h)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(inviteeCreatedV2))
+	req.Header.Set(SignatureHeader, Sign(testSigningKey, time.Now(), []byte(inviteeCreatedV2)))
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal(1, called)
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(inviteeCreatedV2))
+	req.Header.Set(SignatureHeader, Sign("other", time.Now(), []byte(inviteeCreatedV2)))
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+	assert.Equal(1, called)
+}
+
+func TestVerifier_MiddlewareMaxBodyBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	// a valid delivery padded beyond the default limit
+	body := inviteeCreatedV2 + strings.Repeat(" ", DefaultMaxBodyBytes)
+	deliver := func(srv http.Handler) int {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(SignatureHeader, Sign(testSigningKey, time.Now(), []byte(body)))
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	called := 0
+	h := &Handler{MaxBodyBytes: 2 * DefaultMaxBodyBytes}
+	h.OnInviteeCreated(func(ctx context.Context, e *InviteeCreatedEvent) error {
+		called++
+		return nil
+	})
+
+	// the limit of the wrapped handler applies
+	assert.Equal(http.StatusOK, deliver(NewVerifier(testSigningKey).Middleware(h)))
+	assert.Equal(1, called)
+
+	// unless the verifier has its own
+	v := &Verifier{SigningKey: testSigningKey, MaxBodyBytes: 10}
+	assert.Equal(http.StatusRequestEntityTooLarge, deliver(v.Middleware(h)))
+
+	other := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	assert.Equal(http.StatusRequestEntityTooLarge, deliver(NewVerifier(testSigningKey).Middleware(other)))
+	v = &Verifier{SigningKey: testSigningKey, MaxBodyBytes: 2 * DefaultMaxBodyBytes}
+	assert.Equal(http.StatusOK, deliver(v.Middleware(other)))
+	assert.Equal(1, called)
+}
@@ -0,0 +1,367 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	mathrand "math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+const simulatedURI = "https://api.calendly.com"
+
+// ErrDeliveryRejected is matched by the DeliveryError of rejected simulated deliveries.
+var ErrDeliveryRejected = errors.New("go-calendly: webhook delivery was rejected")
+
+// DeliveryError occurs when the receiver answers a simulated delivery with a status other than 2xx.
+type DeliveryError struct {
+	StatusCode int
+
+	// Beginning of the response body
+	Message string
+}
+
+func (e *DeliveryError) Is(target error) bool { return target == ErrDeliveryRejected }
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("go-calendly: webhook delivery was rejected: %d %v", e.StatusCode, e.Message)
+}
+
+// Template describes the booking the simulated invitee events are generated from.
+// Events generated from the same Template refer to the same scheduled event and invitee,
+// so a booking and its cancellation can be delivered together.
+type Template struct {
+	// URIs of the scheduled event and of the invitee
+	EventURI   string
+	InviteeURI string
+
+	// URI and name of the booked event type
+	EventTypeURI string
+	EventName    string
+
+	StartTime time.Time
+	Duration  time.Duration
+	Location  *calendly.Location
+
+	// URI and name of the host, reported as the creator of the deliveries
+	Host     string
+	HostName string
+
+	InviteeName  string
+	InviteeEmail string
+	Timezone     string
+
+	QuestionsAndAnswers []*calendly.QuestionAndAnswer
+	Tracking            *calendly.Tracking
+}
+
+// NewTemplate returns a Template of a 30 minutes meeting booked tomorrow by a sample invitee.
+func NewTemplate() *Template {
+	event := simulatedURI + "/scheduled_events/" + randomUUID()
+
+	return &Template{
+		EventURI:     event,
+		InviteeURI:   event + "/invitees/" + randomUUID(),
+		EventTypeURI: simulatedURI + "/event_types/" + randomUUID(),
+		EventName:    "30 Minute Meeting",
+		StartTime:    time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour),
+		Duration:     30 * time.Minute,
+		Host:         simulatedURI + "/users/" + randomUUID(),
+		HostName:     "Sample Host",
+		InviteeName:  "Sample Invitee",
+		InviteeEmail: "invitee@example.com",
+		Timezone:     "UTC",
+	}
+}
+
+// TemplateFromEventType returns a Template booking the given event type, answering every
+// enabled question of its booking form.
+func TemplateFromEventType(et *calendly.EventType) *Template {
+	t := NewTemplate()
+	if et == nil {
+		return t
+	}
+
+	t.EventTypeURI = et.ID
+	a := et.Attributes
+	if a == nil {
+		return t
+	}
+
+	t.EventName = a.Name
+	if a.Duration > 0 {
+		t.Duration = a.Duration
+	}
+	if a.Profile != nil && a.Profile.Owner != "" {
+		t.Host = a.Profile.Owner
+		t.HostName = a.Profile.Name
+	}
+
+	for _, q := range a.CustomQuestions {
+		if !q.Enabled {
+			continue
+		}
+
+		answer := "Sample answer"
+		if len(q.AnswerChoices) > 0 {
+			answer = q.AnswerChoices[0]
+		}
+		t.QuestionsAndAnswers = append(t.QuestionsAndAnswers, &calendly.QuestionAndAnswer{
+			Question: q.Name,
+			Answer:   answer,
+			Position: q.Position,
+		})
+	}
+
+	return t
+}
+
+// InviteeCreated returns the invitee.created event of the booking, triggered at the given time.
+func (t *Template) InviteeCreated(at time.Time) *InviteeCreatedEvent {
+	return &InviteeCreatedEvent{
+		Envelope: Envelope{Event: calendly.InviteeCreatedHookType, CreatedAt: at, CreatedBy: t.Host},
+		Payload:  t.payload(at, nil),
+	}
+}
+
+// InviteeCancelled returns the invitee.canceled event of the booking, canceled by the
+// invitee at the given time.
+func (t *Template) InviteeCancelled(at time.Time, reason string) *InviteeCancelledEvent {
+	c := &calendly.Cancellation{
+		CanceledBy:   t.InviteeName,
+		Reason:       reason,
+		CancelerType: "invitee",
+		CreatedAt:    at,
+	}
+
+	return &InviteeCancelledEvent{
+		Envelope: Envelope{Event: calendly.InviteeCanceledHookType, CreatedAt: at, CreatedBy: t.Host},
+		Payload:  t.payload(at, c),
+	}
+}
+
+func (t *Template) payload(at time.Time, c *calendly.Cancellation) *InviteePayload {
+	event := &calendly.ScheduledEvent{
+		URI:       t.EventURI,
+		Name:      t.EventName,
+		Status:    calendly.EventStatusActive,
+		StartTime: t.StartTime,
+		EndTime:   t.StartTime.Add(t.Duration),
+		EventType: t.EventTypeURI,
+		Location:  t.Location,
+		Memberships: []*calendly.EventMembership{
+			{User: t.Host, UserName: t.HostName},
+		},
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+
+	invitee := &calendly.Invitee{
+		URI:                 t.InviteeURI,
+		Email:               t.InviteeEmail,
+		Name:                t.InviteeName,
+		Status:              calendly.InviteeStatusActive,
+		Timezone:            t.Timezone,
+		Event:               t.EventURI,
+		QuestionsAndAnswers: t.QuestionsAndAnswers,
+		Tracking:            t.Tracking,
+		CancelURL:           "https://calendly.com/cancellations/" + calendly.UUIDFromURI(t.InviteeURI),
+		RescheduleURL:       "https://calendly.com/reschedulings/" + calendly.UUIDFromURI(t.InviteeURI),
+		CreatedAt:           at,
+		UpdatedAt:           at,
+	}
+
+	if c != nil {
+		event.Status = calendly.EventStatusCanceled
+		event.Cancellation = c
+		invitee.Status = calendly.InviteeStatusCanceled
+		invitee.Cancellation = c
+	}
+
+	return &InviteePayload{
+		Event:               event,
+		Invitee:             invitee,
+		QuestionsAndAnswers: t.QuestionsAndAnswers,
+		Tracking:            t.Tracking,
+		Cancellation:        c,
+	}
+}
+
+// Delivery is a webhook delivery sent by a Simulator.
+type Delivery struct {
+	// Body and signature header of the delivery, the signature being empty when unsigned
+	Body      []byte
+	Signature string
+
+	// Status code the receiver answered with
+	StatusCode int
+}
+
+// DeliveryOpts specifies how a Simulator sends a batch of deliveries.
+type DeliveryOpts struct {
+	// Number of times every delivery is sent, as Calendly may deliver an event more than once.
+	// Every event is sent once when zero.
+	Copies int
+
+	// Send the deliveries in reverse order, e.g. a cancellation before its booking
+	Reverse bool
+
+	// Shuffle the deliveries with this source when set
+	Shuffle *mathrand.Rand
+}
+
+// Simulator sends generated webhook deliveries to a receiver, signed like the deliveries of
+// a subscription created with a signing key. It makes receivers testable without booking
+// real events:
+//
+//	sim := webhook.NewSimulator("http://localhost:8080/calendly", signingKey)
+//	t := webhook.NewTemplate()
+//	_, err := sim.DeliverAll(ctx, []interface{}{
+//		t.InviteeCreated(time.Now()),
+//		t.InviteeCancelled(time.Now(), "Conflict"),
+//	}, &webhook.DeliveryOpts{Reverse: true})
+type Simulator struct {
+	// Callback URL the deliveries are POSTed to
+	URL string
+
+	// Signing key of the simulated subscription, deliveries are not signed when empty
+	SigningKey string
+
+	// HTTP client sending the deliveries, http.DefaultClient when nil
+	Client *http.Client
+
+	// now returns the current time, time.Now when nil
+	now func() time.Time
+}
+
+// NewSimulator returns a Simulator delivering to url and signing with signingKey.
+func NewSimulator(url, signingKey string) *Simulator {
+	return &Simulator{URL: url, SigningKey: signingKey}
+}
+
+// Deliver encodes event, typically generated by a Template, signs it and sends it.
+func (s *Simulator) Deliver(ctx context.Context, event interface{}) (*Delivery, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Send(ctx, body)
+}
+
+// Send signs body as it is and sends it, e.g. a payload loaded from a file.
+func (s *Simulator) Send(ctx context.Context, body []byte) (*Delivery, error) {
+	d := &Delivery{Body: body}
+	if s.SigningKey != "" {
+		now := time.Now
+		if s.now != nil {
+			now = s.now
+		}
+		d.Signature = Sign(s.SigningKey, now(), body)
+	}
+
+	return d, s.post(ctx, d)
+}
+
+// Replay sends a previous delivery again with its original signature, as an attacker
+// replaying a captured delivery would. Receivers verifying signatures reject it once the
+// signature is older than their tolerance.
+func (s *Simulator) Replay(ctx context.Context, d *Delivery) (*Delivery, error) {
+	replay := &Delivery{Body: d.Body, Signature: d.Signature}
+
+	return replay, s.post(ctx, replay)
+}
+
+// DeliverAll sends the events in the order and with the copies given by opt, stopping at
+// the first failed delivery. It returns the deliveries sent so far.
+func (s *Simulator) DeliverAll(ctx context.Context, events []interface{}, opt *DeliveryOpts) ([]*Delivery, error) {
+	if opt == nil {
+		opt = &DeliveryOpts{}
+	}
+
+	copies := opt.Copies
+	if copies < 1 {
+		copies = 1
+	}
+
+	ordered := make([]interface{}, 0, len(events)*copies)
+	for _, e := range events {
+		for i := 0; i < copies; i++ {
+			ordered = append(ordered, e)
+		}
+	}
+
+	if opt.Reverse {
+		for i, j := 0, len(ordered)-1; i < j; i, j = i+1, j-1 {
+			ordered[i], ordered[j] = ordered[j], ordered[i]
+		}
+	}
+	if opt.Shuffle != nil {
+		opt.Shuffle.Shuffle(len(ordered), func(i, j int) {
+			ordered[i], ordered[j] = ordered[j], ordered[i]
+		})
+	}
+
+	deliveries := make([]*Delivery, 0, len(ordered))
+	for _, e := range ordered {
+		d, err := s.Deliver(ctx, e)
+		if d != nil {
+			deliveries = append(deliveries, d)
+		}
+		if err != nil {
+			return deliveries, err
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (s *Simulator) post(ctx context.Context, d *Delivery) error {
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Calendly-Webhook-Simulator")
+	if d.Signature != "" {
+		req.Header.Set(SignatureHeader, d.Signature)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	d.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return &DeliveryError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(msg))}
+	}
+
+	return nil
+}
+
+// randomUUID returns a random identifier formatted like the UUIDs of the Calendly resources.
+func randomUUID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+
+	return strings.ToUpper(hex.EncodeToString(b))
+}
@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theodesp/go-calendly/calendly"
+)
+
+// receiver records the deliveries of a Handler behind a Verifier.
+type receiver struct {
+	mu     sync.Mutex
+	events []interface{}
+}
+
+func newReceiver(v *Verifier) (*receiver, *httptest.Server) {
+	r := &receiver{}
+	h := NewHandler()
+	h.OnInviteeCreated(func(ctx context.Context, e *InviteeCreatedEvent) error {
+		r.record(e)
+		return nil
+	})
+	h.OnInviteeCancelled(func(ctx context.Context, e *InviteeCancelledEvent) error {
+		r.record(e)
+		return nil
+	})
+
+	return r, httptest.NewServer(v.Middleware(h))
+}
+
+func (r *receiver) record(e interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, e)
+}
+
+func TestSimulator_Deliver(t *testing.T) {
+	assert := assert.New(t)
+	r, server := newReceiver(NewVerifier("key"))
+	defer server.Close()
+
+	tmpl := NewTemplate()
+	d, err := NewSimulator(server.URL, "key").Deliver(context.Background(), tmpl.InviteeCreated(time.Now()))
+
+	assert.Nil(err)
+	assert.Equal(http.StatusOK, d.StatusCode)
+	assert.Len(r.events, 1)
+
+	created := r.events[0].(*InviteeCreatedEvent)
+	assert.Equal(tmpl.InviteeURI, created.Payload.Invitee.URI)
+	assert.Equal(tmpl.EventURI, created.Payload.Event.URI)
+	assert.Equal(30*time.Minute, created.Payload.Event.EndTime.Sub(created.Payload.Event.StartTime))
+}
+
+func TestSimulator_DeliverAll(t *testing.T) {
+	assert := assert.New(t)
+	r, server := newReceiver(NewVerifier("key"))
+	defer server.Close()
+
+	tmpl := NewTemplate()
+	now := time.Now()
+	events := []interface{}{tmpl.InviteeCreated(now), tmpl.InviteeCancelled(now, "Conflict")}
+
+	deliveries, err := NewSimulator(server.URL, "key").DeliverAll(context.Background(), events,
+		&DeliveryOpts{Copies: 2, Reverse: true})
+
+	assert.Nil(err)
+	assert.Len(deliveries, 4)
+	assert.Len(r.events, 4)
+	assert.IsType(&InviteeCancelledEvent{}, r.events[0])
+	assert.IsType(&InviteeCancelledEvent{}, r.events[1])
+	assert.IsType(&InviteeCreatedEvent{}, r.events[2])
+	assert.Equal("Conflict", r.events[0].(*InviteeCancelledEvent).CancellationReason())
+	assert.Equal(r.events[2].(*InviteeCreatedEvent).Payload.Invitee.URI,
+		r.events[0].(*InviteeCancelledEvent).Payload.Invitee.URI)
+}
+
+func TestSimulator_Replay(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	var mu sync.Mutex
+	skew := time.Duration(0)
+	v := NewVerifier("key")
+	v.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now.Add(skew)
+	}
+	_, server := newReceiver(v)
+	defer server.Close()
+
+	sim := NewSimulator(server.URL, "key")
+	sim.now = func() time.Time { return now.Add(-time.Hour) }
+
+	d, err := sim.Deliver(context.Background(), NewTemplate().InviteeCreated(now))
+
+	assert.True(errors.Is(err, ErrDeliveryRejected))
+	assert.Equal(http.StatusUnauthorized, d.StatusCode)
+
+	sim.now = nil
+	d, err = sim.Deliver(context.Background(), NewTemplate().InviteeCreated(now))
+	assert.Nil(err)
+
+	replay, err := sim.Replay(context.Background(), d)
+	assert.Nil(err)
+	assert.Equal(d.Signature, replay.Signature)
+
+	mu.Lock()
+	skew = time.Hour
+	mu.Unlock()
+	_, err = sim.Replay(context.Background(), d)
+
+	var derr *DeliveryError
+	assert.True(errors.As(err, &derr))
+	assert.Equal(http.StatusUnauthorized, derr.StatusCode)
+}
+
+func TestSimulator_Unsigned(t *testing.T) {
+	assert := assert.New(t)
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(SignatureHeader)
+	}))
+	defer server.Close()
+
+	d, err := NewSimulator(server.URL, "").Send(context.Background(), []byte(inviteeCreatedV2))
+
+	assert.Nil(err)
+	assert.Empty(d.Signature)
+	assert.Empty(signature)
+}
+
+func TestTemplateFromEventType(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpl := TemplateFromEventType(&calendly.EventType{
+		ID: "https://api.calendly.com/event_types/ET1",
+		Attributes: &calendly.EventTypeAttributes{
+			Name:     "Intro",
+			Duration: 15 * time.Minute,
+			Profile:  &calendly.EventTypeProfile{Name: "Jane", Owner: "https://api.calendly.com/users/U1"},
+			CustomQuestions: []*calendly.CustomQuestion{
+				{Name: "Company?", Enabled: true, Position: 0},
+				{Name: "Size?", Enabled: true, Position: 1, AnswerChoices: []string{"1-10", "11-50"}},
+				{Name: "Disabled?", Enabled: false, Position: 2},
+			},
+		},
+	})
+
+	e := tmpl.InviteeCreated(time.Now())
+	assert.Equal("https://api.calendly.com/users/U1", e.CreatedBy)
+	assert.Equal("https://api.calendly.com/event_types/ET1", e.Payload.Event.EventType)
+	assert.Equal("Intro", e.Payload.Event.Name)
+	assert.Equal(15*time.Minute, e.Payload.Event.EndTime.Sub(e.Payload.Event.StartTime))
+	assert.Equal([]*calendly.QuestionAndAnswer{
+		{Question: "Company?", Answer: "Sample answer", Position: 0},
+		{Question: "Size?", Answer: "1-10", Position: 1},
+	}, e.Payload.QuestionsAndAnswers)
+}
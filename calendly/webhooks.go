@@ -2,41 +2,118 @@ package calendly
 
 import (
 	"context"
-	"net/url"
 	"errors"
-	"bytes"
 	"fmt"
+	"net/url"
 )
 
 const (
-	webhooksPath = "hooks"
+	webhooksPath   = "hooks"
 	getWebhookpath = "hooks/%v"
+
+	webhookSubscriptionsPath = "webhook_subscriptions"
+
+	// Scopes of the API v2 webhook subscriptions
+	WebhookScopeUser         WebhookScope = "user"
+	WebhookScopeOrganization WebhookScope = "organization"
+
+	// States of the webhook subscriptions
+	WebhookStateActive   WebhookState = "active"
+	WebhookStateDisabled WebhookState = "disabled"
 )
 
+// WebhooksAPI is the interface of the webhooks service, implemented by WebhooksService.
+type WebhooksAPI interface {
+	Create(ctx context.Context, opt *WebhooksOpts) (*Webhook, *Response, error)
+	List(ctx context.Context) ([]*Webhook, *Response, error)
+	Iter(opt *ListOptions) *Iterator[*Webhook]
+	ListSubscriptions(ctx context.Context, opt *WebhookSubscriptionsOpts) ([]*Webhook, *Response, error)
+	IterSubscriptions(opt *WebhookSubscriptionsOpts) *Iterator[*Webhook]
+	GetByID(ctx context.Context, id int64) (*Webhook, *Response, error)
+	GetByURI(ctx context.Context, uri string) (*Webhook, *Response, error)
+	Delete(ctx context.Context, id int64) (*Response, error)
+	DeleteByURI(ctx context.Context, uri string) (*Response, error)
+	Plan(ctx context.Context, desired []*WebhooksOpts, opt *ReconcileOpts) (*WebhookPlan, error)
+	Apply(ctx context.Context, plan *WebhookPlan) error
+	Reconcile(ctx context.Context, desired []*WebhooksOpts, opt *ReconcileOpts) (*WebhookPlan, error)
+}
+
 type WebhooksService apiService
 
 type Webhook struct {
-	Type       string     `json:"type"`
-	ID         int64      `json:"id"`
+	Type       string             `json:"type"`
+	ID         int64              `json:"id"`
 	Attributes *WebhookAttributes `json:"attributes,omitempty"`
+
+	// URI identifies the webhook subscription with API v2, which has no numeric IDs.
+	URI string `json:"uri,omitempty"`
 }
 
 type WebhookAttributes struct {
-	URL       string  `json:"url"`
-	CreatedAt string  `json:"created_at"`
-	State     string  `json:"state"`
+	URL       string          `json:"url"`
+	CreatedAt string          `json:"created_at"`
+	State     WebhookState    `json:"state"`
 	Events    []EventHookType `json:"events"`
+
+	// The following fields are only returned by API v2
+	UpdatedAt    string       `json:"updated_at,omitempty"`
+	Scope        WebhookScope `json:"scope,omitempty"`
+	Organization string       `json:"organization,omitempty"`
+	User         string       `json:"user,omitempty"`
+	Creator      string       `json:"creator,omitempty"`
 }
 
 type EventHookType string
+
 const (
-	InviteeCreatedHookType EventHookType  = "invitee.created"
-	InviteeCancelledHookType EventHookType  = "invitee.cancelled"
+	InviteeCreatedHookType   EventHookType = "invitee.created"
+	InviteeCancelledHookType EventHookType = "invitee.cancelled"
+
+	// The following event kinds are only supported by API v2, which spells the
+	// cancellation event "invitee.canceled"
+	InviteeCanceledHookType              EventHookType = "invitee.canceled"
+	InviteeNoShowCreatedHookType         EventHookType = "invitee_no_show.created"
+	InviteeNoShowDeletedHookType         EventHookType = "invitee_no_show.deleted"
+	RoutingFormSubmissionCreatedHookType EventHookType = "routing_form_submission.created"
 )
 
+// Scope of a webhook subscription, whether it receives the events of a single user
+// or of the whole organization
+type WebhookScope string
+
+// State of a webhook subscription
+type WebhookState string
+
 type WebhooksOpts struct {
-	Url string
+	Url    string
 	Events []EventHookType
+
+	// The following options are only honoured by API v2. The subscription is scoped to the
+	// current user by default. Organization and User default to the current organization
+	// and the current user.
+	Scope        WebhookScope
+	Organization string
+	User         string
+
+	// Key signing the deliveries, see the webhook package to verify them
+	SigningKey string
+}
+
+// WebhookSubscriptionsOpts filters the API v2 webhook subscriptions.
+type WebhookSubscriptionsOpts struct {
+	// Scope of the subscriptions, WebhookScopeUser by default
+	Scope WebhookScope `url:"scope"`
+
+	// URIs of the organization and user of the subscriptions, the current organization
+	// and user by default. User is ignored for organization scoped subscriptions.
+	Organization string `url:"organization"`
+	User         string `url:"user,omitempty"`
+
+	// Return only the subscriptions in this state. Calendly does not filter by state,
+	// so the subscriptions of each page are filtered after being fetched.
+	State WebhookState `url:"-"`
+
+	ListOptions
 }
 
 // Calendly supports webhooks which allow you to receive Calendly
@@ -44,30 +121,32 @@ type WebhooksOpts struct {
 //
 // Specifically, you can subscribe to:
 //
-//  * Invitee Created Events (allowing you to receive notifications when a new Calendly event is created)
-//  * Invitee Canceled Events (allowing you to receive notifications when a Calendly event is canceled)
-// Creating a Webhook Subscription will not immediately trigger a webhook. So once it's set up, create or cancel an invitee to test it out.
-func (s *WebhooksService)Create(ctx context.Context, opt *WebhooksOpts) (*Webhook, *Response, error)  {
+//   - Invitee Created Events (allowing you to receive notifications when a new Calendly event is created)
+//   - Invitee Canceled Events (allowing you to receive notifications when a Calendly event is canceled)
+//
+// Creating a Webhook Subscription will not immediately trigger a webhook. So once it's set up, create or cancel an invitee to test it out,
+// or send simulated deliveries to the callback URL with webhook.Simulator.
+//
+// API v2 subscriptions can also receive invitee no-show and routing form submission events,
+// be scoped to the whole organization and sign their deliveries.
+func (s *WebhooksService) Create(ctx context.Context, opt *WebhooksOpts) (*Webhook, *Response, error) {
 	if opt == nil {
 		return nil, nil, errors.New("go-calendly: webhooks.create required options")
 	}
 
 	u, err := url.Parse(opt.Url)
-	if err != nil {
+	if err != nil || !u.IsAbs() {
 		return nil, nil, errors.New("go-calendly: webhooks.create url is not valid")
 	}
 
-	buf := bytes.NewBufferString("")
-	buf.WriteString(url.QueryEscape(fmt.Sprintf("%v=%v", "url", u)))
-	for _, ht := range opt.Events {
-		buf.WriteString(url.QueryEscape(fmt.Sprintf("&%v=%v", "events[]", ht)))
+	if s.client.version == APIV2 {
+		return s.createV2(ctx, u.String(), opt)
 	}
 
-	req, err := s.client.Post(webhooksPath, buf.String())
+	req, err := s.client.Post(webhooksPath, &webhookV1Request{URL: u.String(), Events: opt.Events})
 	if err != nil {
 		return nil, nil, err
 	}
-	req.Header.Set("Content-Type", textType)
 
 	wh := &Webhook{}
 	resp, err := s.client.Do(ctx, req, wh)
@@ -82,7 +161,13 @@ func (s *WebhooksService)Create(ctx context.Context, opt *WebhooksOpts) (*Webhoo
 //
 // Using this endpoint will list up to the first 100 Webhook Subscriptions,
 // and it will order active subscriptions first.
-func (s *WebhooksService)List(ctx context.Context) ([]*Webhook, *Response, error)  {
+//
+// With API v2 the user scoped subscriptions of the current user are listed.
+func (s *WebhooksService) List(ctx context.Context) ([]*Webhook, *Response, error) {
+	if s.client.version == APIV2 {
+		return s.ListSubscriptions(ctx, nil)
+	}
+
 	req, err := s.client.Get(webhooksPath)
 	if err != nil {
 		return nil, nil, err
@@ -97,8 +182,44 @@ func (s *WebhooksService)List(ctx context.Context) ([]*Webhook, *Response, error
 	return wh.Webhooks, resp, nil
 }
 
+// Iter returns an Iterator over all your Webhook Subscriptions. With API v1 there is a single page
+// of up to 100 subscriptions, API v2 is paged according to the options.
+func (s *WebhooksService) Iter(opt *ListOptions) *Iterator[*Webhook] {
+	o := ListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*Webhook, *Response, error) {
+		if s.client.version != APIV2 {
+			return s.List(ctx)
+		}
+
+		o.PageToken = pageToken
+		return s.ListSubscriptions(ctx, &WebhookSubscriptionsOpts{ListOptions: o})
+	})
+}
+
+// IterSubscriptions returns an Iterator over all the API v2 webhook subscriptions matching the options.
+func (s *WebhooksService) IterSubscriptions(opt *WebhookSubscriptionsOpts) *Iterator[*Webhook] {
+	o := WebhookSubscriptionsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIterator(o.PageToken, func(ctx context.Context, pageToken string) ([]*Webhook, *Response, error) {
+		o.PageToken = pageToken
+		return s.ListSubscriptions(ctx, &o)
+	})
+}
+
 // Any of your Webhook Subscriptions can be accessed by ID using this endpoint.
-func (s *WebhooksService)GetByID(ctx context.Context, id int64) (*Webhook, *Response, error)  {
+// API v1 only, use GetByURI with API v2.
+func (s *WebhooksService) GetByID(ctx context.Context, id int64) (*Webhook, *Response, error) {
+	if s.client.version == APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
 	req, err := s.client.Get(fmt.Sprintf(getWebhookpath, id))
 	if err != nil {
 		return nil, nil, err
@@ -114,7 +235,12 @@ func (s *WebhooksService)GetByID(ctx context.Context, id int64) (*Webhook, *Resp
 }
 
 // Any of your Webhook Subscriptions can be deleted by ID using this endpoint.
-func (s *WebhooksService)Delete(ctx context.Context, id int64) (*Response, error)  {
+// API v1 only, use DeleteByURI with API v2.
+func (s *WebhooksService) Delete(ctx context.Context, id int64) (*Response, error) {
+	if s.client.version == APIV2 {
+		return nil, errUnsupportedVersion
+	}
+
 	req, err := s.client.Delete(fmt.Sprintf(getWebhookpath, id))
 	if err != nil {
 		return nil, err
@@ -135,3 +261,205 @@ type webhookResponse struct {
 type webhookListResponse struct {
 	Webhooks []*Webhook `json:"data"`
 }
+
+// webhookV2 is the API v2 representation of a webhook subscription.
+type webhookV2 struct {
+	URI          string          `json:"uri"`
+	CallbackURL  string          `json:"callback_url"`
+	CreatedAt    string          `json:"created_at"`
+	UpdatedAt    string          `json:"updated_at"`
+	State        WebhookState    `json:"state"`
+	Events       []EventHookType `json:"events"`
+	Scope        WebhookScope    `json:"scope"`
+	Organization string          `json:"organization"`
+	User         string          `json:"user"`
+	Creator      string          `json:"creator"`
+}
+
+type webhookV2Response struct {
+	Resource *webhookV2 `json:"resource"`
+}
+
+type webhookV2ListResponse struct {
+	Collection []*webhookV2 `json:"collection"`
+	Pagination *Pagination  `json:"pagination"`
+}
+
+type webhookV1Request struct {
+	URL    string          `json:"url"`
+	Events []EventHookType `json:"events"`
+}
+
+type webhookV2Request struct {
+	URL          string          `json:"url"`
+	Events       []EventHookType `json:"events"`
+	Organization string          `json:"organization"`
+	User         string          `json:"user,omitempty"`
+	Scope        WebhookScope    `json:"scope"`
+	SigningKey   string          `json:"signing_key,omitempty"`
+}
+
+func (r *webhookV2ListResponse) pagination() *Pagination {
+	return r.Pagination
+}
+
+func (w *webhookV2) toWebhook() *Webhook {
+	if w == nil {
+		return nil
+	}
+
+	return &Webhook{
+		Type: "webhook_subscriptions",
+		URI:  w.URI,
+		Attributes: &WebhookAttributes{
+			URL:          w.CallbackURL,
+			CreatedAt:    w.CreatedAt,
+			UpdatedAt:    w.UpdatedAt,
+			State:        w.State,
+			Events:       w.Events,
+			Scope:        w.Scope,
+			Organization: w.Organization,
+			User:         w.User,
+			Creator:      w.Creator,
+		},
+	}
+}
+
+// Any of your Webhook Subscriptions can be accessed by URI using this endpoint. API v2 only.
+func (s *WebhooksService) GetByURI(ctx context.Context, uri string) (*Webhook, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Get(resourcePath(webhookSubscriptionsPath, uri))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wh := &webhookV2Response{}
+	resp, err := s.client.Do(ctx, req, wh)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wh.Resource.toWebhook(), resp, nil
+}
+
+// Any of your Webhook Subscriptions can be deleted by URI using this endpoint. API v2 only.
+func (s *WebhooksService) DeleteByURI(ctx context.Context, uri string) (*Response, error) {
+	if s.client.version != APIV2 {
+		return nil, errUnsupportedVersion
+	}
+
+	req, err := s.client.Delete(resourcePath(webhookSubscriptionsPath, uri))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *WebhooksService) createV2(ctx context.Context, callbackURL string, opt *WebhooksOpts) (*Webhook, *Response, error) {
+	body := &webhookV2Request{
+		URL:          callbackURL,
+		Events:       opt.Events,
+		Organization: opt.Organization,
+		User:         opt.User,
+		Scope:        opt.Scope,
+		SigningKey:   opt.SigningKey,
+	}
+	if body.Scope == "" {
+		body.Scope = WebhookScopeUser
+	}
+
+	if err := s.defaultOwner(ctx, body.Scope, &body.Organization, &body.User); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Post(webhookSubscriptionsPath, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wh := &webhookV2Response{}
+	resp, err := s.client.Do(ctx, req, wh)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wh.Resource.toWebhook(), resp, nil
+}
+
+// ListSubscriptions returns the API v2 webhook subscriptions matching the options, the user
+// scoped subscriptions of the current user by default. API v2 only.
+func (s *WebhooksService) ListSubscriptions(ctx context.Context, opt *WebhookSubscriptionsOpts) ([]*Webhook, *Response, error) {
+	if s.client.version != APIV2 {
+		return nil, nil, errUnsupportedVersion
+	}
+
+	o := WebhookSubscriptionsOpts{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.Scope == "" {
+		o.Scope = WebhookScopeUser
+	}
+
+	if err := s.defaultOwner(ctx, o.Scope, &o.Organization, &o.User); err != nil {
+		return nil, nil, err
+	}
+	if o.Scope == WebhookScopeOrganization {
+		o.User = ""
+	}
+
+	u, err := addUrlOptions(webhookSubscriptionsPath, &o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.Get(u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wh := &webhookV2ListResponse{}
+	resp, err := s.client.Do(ctx, req, wh)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	webhooks := make([]*Webhook, 0, len(wh.Collection))
+	for _, w := range wh.Collection {
+		if o.State != "" && w.State != o.State {
+			continue
+		}
+		webhooks = append(webhooks, w.toWebhook())
+	}
+
+	return webhooks, resp, nil
+}
+
+// defaultOwner fills the organization, and the user of user scoped subscriptions, from the
+// current user when they are not set.
+func (s *WebhooksService) defaultOwner(ctx context.Context, scope WebhookScope, organization, user *string) error {
+	if *organization != "" && (scope == WebhookScopeOrganization || *user != "") {
+		return nil
+	}
+
+	me, _, err := s.client.Users.AboutMe(ctx)
+	if err != nil {
+		return err
+	}
+	if me == nil || me.Attributes == nil {
+		return ErrNoUser
+	}
+
+	if *organization == "" {
+		*organization = me.Attributes.CurrentOrganization
+	}
+	if *user == "" && scope == WebhookScopeUser {
+		*user = me.ID
+	}
+
+	return nil
+}
@@ -1,12 +1,11 @@
 package calendly
 
 import (
-	"github.com/stretchr/testify/assert"
-	"fmt"
-	"net/http"
 	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
 	"io/ioutil"
-	"net/url"
+	"net/http"
 )
 
 func (suite *CalendlyClientTestSuite) TestWebhooksService_Create() {
@@ -16,15 +15,16 @@ func (suite *CalendlyClientTestSuite) TestWebhooksService_Create() {
 	suite.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, http.MethodPost)
 
+		assert.Equal(mediaType, r.Header.Get("Content-Type"))
+
 		body, _ := ioutil.ReadAll(r.Body)
-		expected := `%22url%253Dhttp%253A%252F%252Fwebhook%2526events%255B%255D%253Dinvitee.cancelled%22%0A`
-		assert.Equal(expected, url.QueryEscape(string(body)))
+		assert.Equal(`{"url":"http://webhook","events":["invitee.cancelled"]}`+"\n", string(body))
 
 		w.WriteHeader(http.StatusCreated)
 		fmt.Fprint(w, `{"id":123}`)
 	})
 	opts := &WebhooksOpts{
-		Url: "http://webhook",
+		Url:    "http://webhook",
 		Events: []EventHookType{InviteeCancelledHookType},
 	}
 	v, resp, err := suite.client.Webhooks.Create(context.Background(), opts)
@@ -35,7 +35,6 @@ func (suite *CalendlyClientTestSuite) TestWebhooksService_Create() {
 	assert.Equal(http.StatusCreated, resp.StatusCode)
 }
 
-
 func (suite *CalendlyClientTestSuite) TestWebhooksService_GetByID() {
 	assert := assert.New(suite.T())
 	route := fmt.Sprintf("/%s", fmt.Sprintf(getWebhookpath, "1"))
@@ -102,3 +101,113 @@ func (suite *CalendlyClientTestSuite) TestWebhooksService_InvalidParams() {
 	})
 	assert.NotNil(err)
 }
+
+func (suite *CalendlyClientTestSuite) TestWebhooksService_CreateV2() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	suite.handleAboutMeV2()
+
+	suite.mux.HandleFunc("/"+webhookSubscriptionsPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodPost)
+		assert.Equal(mediaType, r.Header.Get("Content-Type"))
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"url":"http://webhook","events":["invitee.created"],` +
+			`"organization":"https://api.calendly.com/organizations/O1",` +
+			`"user":"https://api.calendly.com/users/U1","scope":"user"}` + "\n"
+		assert.Equal(expected, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/webhook_subscriptions/W1","state":"active"}}`)
+	})
+
+	v, _, err := suite.client.Webhooks.Create(context.Background(), &WebhooksOpts{
+		Url:    "http://webhook",
+		Events: []EventHookType{InviteeCreatedHookType},
+	})
+	assert.Nil(err)
+
+	want := &Webhook{
+		Type:       "webhook_subscriptions",
+		URI:        "https://api.calendly.com/webhook_subscriptions/W1",
+		Attributes: &WebhookAttributes{State: "active"},
+	}
+	assert.Equal(want, v)
+}
+
+func (suite *CalendlyClientTestSuite) TestWebhooksService_GetByURIV2() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+webhookSubscriptionsPath+"/W1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/webhook_subscriptions/W1"}}`)
+	})
+
+	webHook, _, err := suite.client.Webhooks.GetByURI(context.Background(), "W1")
+	assert.Nil(err)
+	assert.Equal("https://api.calendly.com/webhook_subscriptions/W1", webHook.URI)
+
+	_, _, err = suite.client.Webhooks.GetByID(context.Background(), int64(1))
+	assert.Equal(errUnsupportedVersion, err)
+}
+
+func (suite *CalendlyClientTestSuite) TestWebhooksService_CreateV2Organization() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+	suite.handleAboutMeV2()
+
+	suite.mux.HandleFunc("/"+webhookSubscriptionsPath, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"url":"https://example.com/hooks","events":["invitee.canceled","invitee_no_show.created",` +
+			`"routing_form_submission.created"],"organization":"https://api.calendly.com/organizations/O1",` +
+			`"scope":"organization","signing_key":"secret"}` + "\n"
+		assert.Equal(expected, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resource":{"uri":"W1","scope":"organization","organization":"https://api.calendly.com/organizations/O1"}}`)
+	})
+
+	v, _, err := suite.client.Webhooks.Create(context.Background(), &WebhooksOpts{
+		Url: "https://example.com/hooks",
+		Events: []EventHookType{
+			InviteeCanceledHookType, InviteeNoShowCreatedHookType, RoutingFormSubmissionCreatedHookType,
+		},
+		Scope:      WebhookScopeOrganization,
+		SigningKey: "secret",
+	})
+	assert.Nil(err)
+	assert.Equal(WebhookScopeOrganization, v.Attributes.Scope)
+	assert.Equal("https://api.calendly.com/organizations/O1", v.Attributes.Organization)
+}
+
+func (suite *CalendlyClientTestSuite) TestWebhooksService_ListSubscriptions() {
+	assert := assert.New(suite.T())
+	suite.useAPIV2()
+
+	suite.mux.HandleFunc("/"+webhookSubscriptionsPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, http.MethodGet)
+		q := r.URL.Query()
+		assert.Equal("organization", q.Get("scope"))
+		assert.Equal("https://api.calendly.com/organizations/O2", q.Get("organization"))
+		assert.Empty(q.Get("user"))
+		assert.Equal("P2", q.Get("page_token"))
+		fmt.Fprint(w, `{"collection":[{"uri":"W1","state":"active"},{"uri":"W2","state":"disabled"}],`+
+			`"pagination":{"count":2,"next_page_token":"P3"}}`)
+	})
+
+	webhooks, resp, err := suite.client.Webhooks.ListSubscriptions(context.Background(), &WebhookSubscriptionsOpts{
+		Scope:        WebhookScopeOrganization,
+		Organization: "https://api.calendly.com/organizations/O2",
+		State:        WebhookStateDisabled,
+		ListOptions:  ListOptions{PageToken: "P2"},
+	})
+	assert.Nil(err)
+	assert.Equal("P3", resp.NextPageToken)
+	assert.Len(webhooks, 1)
+	assert.Equal("W2", webhooks[0].URI)
+
+	suite.client = NewClient(nil)
+	_, _, err = suite.client.Webhooks.ListSubscriptions(context.Background(), nil)
+	assert.Equal(errUnsupportedVersion, err)
+}
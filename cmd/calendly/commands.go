@@ -0,0 +1,767 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theodesp/go-calendly/calendly"
+	"github.com/theodesp/go-calendly/calendly/webhook"
+)
+
+// env is what the commands run with.
+type env struct {
+	// API client, nil when no credentials are set and the command runs offline
+	client    *calendly.Client
+	clientErr error
+
+	printer *printer
+	stderr  io.Writer
+}
+
+type command func(ctx context.Context, e *env, args []string) error
+
+var commands = map[string]map[string]command{
+	"whoami":      {"": whoami},
+	"event-types": {"list": listEventTypes},
+	"events":      {"list": listEvents},
+	"invitees":    {"list": listInvitees},
+	"organizations": {
+		"memberships": listMemberships,
+		"invitations": listInvitations,
+	},
+	"availability": {
+		"times":      listAvailableTimes,
+		"busy-times": listBusyTimes,
+		"schedules":  listSchedules,
+	},
+	"scheduling-links": {"create": createSchedulingLink},
+	"webhooks": {
+		"list":     listWebhooks,
+		"get":      getWebhook,
+		"create":   createWebhook,
+		"delete":   deleteWebhook,
+		"simulate": simulateWebhook,
+	},
+}
+
+// offline are the commands that run without credentials.
+var offline = map[string]bool{
+	"webhooks simulate": true,
+}
+
+// lookup returns the name of the command named by the leading args, the command and the remaining args.
+func lookup(args []string) (string, command, []string, error) {
+	subcommands, ok := commands[args[0]]
+	if !ok {
+		return "", nil, nil, fmt.Errorf("calendly: unknown command %q", args[0])
+	}
+
+	if cmd, ok := subcommands[""]; ok {
+		return args[0], cmd, args[1:], nil
+	}
+
+	if len(args) < 2 {
+		return "", nil, nil, fmt.Errorf("calendly: %v requires a subcommand", args[0])
+	}
+
+	name := args[0] + " " + args[1]
+	cmd, ok := subcommands[args[1]]
+	if !ok {
+		return "", nil, nil, fmt.Errorf("calendly: unknown command %q", name)
+	}
+
+	return name, cmd, args[2:], nil
+}
+
+// newFlagSet returns the flags of a command, writing their usage to the error output.
+func newFlagSet(e *env, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	return fs
+}
+
+func whoami(ctx context.Context, e *env, args []string) error {
+	if err := newFlagSet(e, "whoami").Parse(args); err != nil {
+		return err
+	}
+
+	me, err := currentUser(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "NAME", "EMAIL", "TIMEZONE", "ORGANIZATION"}}
+	if a := me.Attributes; a != nil {
+		tz := a.TimezoneName
+		if a.Timezone != nil {
+			tz = a.Timezone.String()
+		}
+		t.add(me.ID, a.Name, a.Email, tz, a.CurrentOrganization)
+	} else {
+		t.add(me.ID, "", "", "", "")
+	}
+
+	return e.printer.print(me, t)
+}
+
+func listEventTypes(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "event-types list")
+	user := fs.String("user", "", "URI of the user, the current user by default (v2)")
+	organization := fs.String("organization", "", "URI of the organization (v2)")
+	active := fs.String("active", "", "list only the active (true) or inactive (false) event types (v2)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	opt := &calendly.EventTypesOpts{User: *user, Organization: *organization}
+	if *active != "" {
+		b, err := strconv.ParseBool(*active)
+		if err != nil {
+			return fmt.Errorf("calendly: invalid -active value %q", *active)
+		}
+		opt.Active = &b
+	}
+
+	eventTypes, err := calendly.ListAll(ctx, e.client.EventTypes.Iter(opt))
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "NAME", "DURATION", "ACTIVE", "URL"}}
+	for _, et := range eventTypes {
+		if a := et.Attributes; a != nil {
+			t.add(et.ID, a.Name, a.Duration, a.Active, a.URL)
+		}
+	}
+
+	return e.printer.print(eventTypes, t)
+}
+
+func listEvents(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "events list")
+	opt := &calendly.ScheduledEventsOpts{}
+	fs.StringVar(&opt.User, "user", "", "URI of the user, the current user by default")
+	fs.StringVar(&opt.Organization, "organization", "", "URI of the organization")
+	fs.StringVar(&opt.InviteeEmail, "invitee-email", "", "list the events booked by this email address")
+	status := fs.String("status", "", "active or canceled")
+	minStart := fs.String("min-start", "", "list events starting after this RFC 3339 time")
+	maxStart := fs.String("max-start", "", "list events starting before this RFC 3339 time")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if err := requireV2(e, "events list"); err != nil {
+		return err
+	}
+
+	opt.Status = calendly.EventStatus(*status)
+	var err error
+	if opt.MinStartTime, err = parseTime(*minStart); err != nil {
+		return err
+	}
+	if opt.MaxStartTime, err = parseTime(*maxStart); err != nil {
+		return err
+	}
+
+	if opt.User == "" && opt.Organization == "" {
+		me, err := currentUser(ctx, e)
+		if err != nil {
+			return err
+		}
+		opt.User = me.ID
+	}
+
+	events, err := calendly.ListAll(ctx, e.client.ScheduledEvents.Iter(opt))
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"URI", "NAME", "STATUS", "START", "END"}}
+	for _, ev := range events {
+		t.add(ev.URI, ev.Name, ev.Status, ev.StartTime.Format(time.RFC3339), ev.EndTime.Format(time.RFC3339))
+	}
+
+	return e.printer.print(events, t)
+}
+
+func listInvitees(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "invitees list <event>")
+	opt := &calendly.InviteesOpts{}
+	fs.StringVar(&opt.Email, "email", "", "list the invitees with this email address")
+	status := fs.String("status", "", "active or canceled")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("calendly: invitees list requires the UUID or URI of a scheduled event")
+	}
+	if err := requireV2(e, "invitees list"); err != nil {
+		return err
+	}
+
+	opt.Status = calendly.InviteeStatus(*status)
+
+	invitees, err := calendly.ListAll(ctx, e.client.Invitees.Iter(fs.Arg(0), opt))
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"URI", "NAME", "EMAIL", "STATUS"}}
+	for _, i := range invitees {
+		t.add(i.URI, i.Name, i.Email, i.Status)
+	}
+
+	return e.printer.print(invitees, t)
+}
+
+func listMemberships(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "organizations memberships")
+	opt := &calendly.MembershipsOpts{}
+	fs.StringVar(&opt.Organization, "organization", "", "URI of the organization, the current organization by default")
+	fs.StringVar(&opt.User, "user", "", "list the memberships of this user URI")
+	fs.StringVar(&opt.Email, "email", "", "list the membership of the user with this email address")
+	role := fs.String("role", "", "owner, admin or user")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireV2(e, "organizations memberships"); err != nil {
+		return err
+	}
+
+	opt.Role = calendly.MembershipRole(*role)
+	if opt.Organization == "" && opt.User == "" {
+		org, err := currentOrganization(ctx, e)
+		if err != nil {
+			return err
+		}
+		opt.Organization = org
+	}
+
+	memberships, err := calendly.ListAll(ctx, e.client.Organizations.IterMemberships(opt))
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"URI", "ROLE", "NAME", "EMAIL"}}
+	for _, m := range memberships {
+		name, email := "", ""
+		if m.User != nil && m.User.Attributes != nil {
+			name, email = m.User.Attributes.Name, m.User.Attributes.Email
+		}
+		t.add(m.URI, m.Role, name, email)
+	}
+
+	return e.printer.print(memberships, t)
+}
+
+func listInvitations(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "organizations invitations")
+	organization := fs.String("organization", "", "URI of the organization, the current organization by default")
+	opt := &calendly.InvitationsOpts{}
+	fs.StringVar(&opt.Email, "email", "", "list the invitations sent to this email address")
+	status := fs.String("status", "", "pending, accepted or declined")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireV2(e, "organizations invitations"); err != nil {
+		return err
+	}
+
+	opt.Status = calendly.InvitationStatus(*status)
+	if *organization == "" {
+		org, err := currentOrganization(ctx, e)
+		if err != nil {
+			return err
+		}
+		*organization = org
+	}
+
+	invitations, err := calendly.ListAll(ctx, e.client.Organizations.IterInvitations(*organization, opt))
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"URI", "EMAIL", "STATUS", "LAST SENT"}}
+	for _, i := range invitations {
+		t.add(i.URI, i.Email, i.Status, i.LastSentAt.Format(time.RFC3339))
+	}
+
+	return e.printer.print(invitations, t)
+}
+
+func listAvailableTimes(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "availability times <event-type>")
+	start := fs.String("start", "", "RFC 3339 start of the window, the next minute by default")
+	end := fs.String("end", "", "RFC 3339 end of the window, a week after its start by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("calendly: availability times requires the URI of an event type")
+	}
+	if err := requireV2(e, "availability times"); err != nil {
+		return err
+	}
+
+	window, err := parseWindow(*start, *end)
+	if err != nil {
+		return err
+	}
+
+	times, _, err := e.client.Availability.EventTypeAvailableTimes(ctx, fs.Arg(0), window)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"START", "STATUS", "REMAINING", "URL"}}
+	for _, at := range times {
+		t.add(at.StartTime.Format(time.RFC3339), at.Status, at.InviteesRemaining, at.SchedulingURL)
+	}
+
+	return e.printer.print(times, t)
+}
+
+func listBusyTimes(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "availability busy-times")
+	user := fs.String("user", "", "URI of the user, the current user by default")
+	start := fs.String("start", "", "RFC 3339 start of the window, the next minute by default")
+	end := fs.String("end", "", "RFC 3339 end of the window, a week after its start by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireV2(e, "availability busy-times"); err != nil {
+		return err
+	}
+
+	window, err := parseWindow(*start, *end)
+	if err != nil {
+		return err
+	}
+	if *user == "" {
+		me, err := currentUser(ctx, e)
+		if err != nil {
+			return err
+		}
+		*user = me.ID
+	}
+
+	busy, _, err := e.client.Availability.UserBusyTimes(ctx, *user, window)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"TYPE", "START", "END", "EVENT"}}
+	for _, b := range busy {
+		event := ""
+		if b.Event != nil {
+			event = b.Event.URI
+		}
+		t.add(b.Type, b.StartTime.Format(time.RFC3339), b.EndTime.Format(time.RFC3339), event)
+	}
+
+	return e.printer.print(busy, t)
+}
+
+func listSchedules(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "availability schedules")
+	user := fs.String("user", "", "URI of the user, the current user by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireV2(e, "availability schedules"); err != nil {
+		return err
+	}
+
+	if *user == "" {
+		me, err := currentUser(ctx, e)
+		if err != nil {
+			return err
+		}
+		*user = me.ID
+	}
+
+	schedules, _, err := e.client.Availability.ListSchedules(ctx, *user)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"URI", "NAME", "DEFAULT", "TIMEZONE", "RULES"}}
+	for _, s := range schedules {
+		tz := s.TimezoneName
+		if s.Timezone != nil {
+			tz = s.Timezone.String()
+		}
+		t.add(s.URI, s.Name, s.Default, tz, len(s.Rules))
+	}
+
+	return e.printer.print(schedules, t)
+}
+
+func createSchedulingLink(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "scheduling-links create <event-type>")
+	maxEvents := fs.Int("max-events", 1, "number of events the link can book")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("calendly: scheduling-links create requires the URI of an event type")
+	}
+	if err := requireV2(e, "scheduling-links create"); err != nil {
+		return err
+	}
+
+	link, _, err := e.client.SchedulingLinks.Create(ctx, fs.Arg(0), *maxEvents)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"BOOKING URL", "OWNER"}}
+	t.add(link.BookingURL, link.Owner)
+
+	return e.printer.print(link, t)
+}
+
+func listWebhooks(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "webhooks list")
+	scope := fs.String("scope", "", "user or organization (v2)")
+	state := fs.String("state", "", "active or disabled (v2)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	it := e.client.Webhooks.Iter(nil)
+	if e.client.APIVersion() == calendly.APIV2 {
+		it = e.client.Webhooks.IterSubscriptions(&calendly.WebhookSubscriptionsOpts{
+			Scope: calendly.WebhookScope(*scope),
+			State: calendly.WebhookState(*state),
+		})
+	}
+
+	webhooks, err := calendly.ListAll(ctx, it)
+	if err != nil {
+		return err
+	}
+
+	return e.printer.print(webhooks, webhooksTable(webhooks...))
+}
+
+func getWebhook(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "webhooks get <id>")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("calendly: webhooks get requires the ID or URI of a webhook subscription")
+	}
+
+	var wh *calendly.Webhook
+	var err error
+	if id, ok := webhookID(e, fs.Arg(0)); ok {
+		wh, _, err = e.client.Webhooks.GetByID(ctx, id)
+	} else {
+		wh, _, err = e.client.Webhooks.GetByURI(ctx, fs.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+
+	return e.printer.print(wh, webhooksTable(wh))
+}
+
+func createWebhook(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "webhooks create")
+	opt := &calendly.WebhooksOpts{}
+	fs.StringVar(&opt.Url, "url", "", "callback URL of the subscription")
+	events := fs.String("events", "invitee.created", "comma separated event kinds")
+	scope := fs.String("scope", "", "user or organization (v2)")
+	fs.StringVar(&opt.SigningKey, "signing-key", "", "key signing the deliveries (v2)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if opt.Url == "" {
+		return errors.New("calendly: webhooks create requires -url")
+	}
+
+	opt.Scope = calendly.WebhookScope(*scope)
+	for _, ev := range strings.Split(*events, ",") {
+		if ev = strings.TrimSpace(ev); ev != "" {
+			opt.Events = append(opt.Events, calendly.EventHookType(ev))
+		}
+	}
+
+	wh, _, err := e.client.Webhooks.Create(ctx, opt)
+	if err != nil {
+		return err
+	}
+
+	return e.printer.print(wh, webhooksTable(wh))
+}
+
+func deleteWebhook(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "webhooks delete <id>")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("calendly: webhooks delete requires the ID or URI of a webhook subscription")
+	}
+
+	var err error
+	if id, ok := webhookID(e, fs.Arg(0)); ok {
+		_, err = e.client.Webhooks.Delete(ctx, id)
+	} else {
+		_, err = e.client.Webhooks.DeleteByURI(ctx, fs.Arg(0))
+	}
+
+	return err
+}
+
+// simulatedDelivery is the result of a delivery sent by webhooks simulate.
+type simulatedDelivery struct {
+	Event      calendly.EventHookType `json:"event"`
+	StatusCode int                    `json:"status_code"`
+	Signed     bool                   `json:"signed"`
+	Replay     bool                   `json:"replay"`
+}
+
+func simulateWebhook(ctx context.Context, e *env, args []string) error {
+	fs := newFlagSet(e, "webhooks simulate")
+	target := fs.String("url", "", "URL of the webhook receiver")
+	signingKey := fs.String("signing-key", "", "key signing the deliveries, unsigned when empty")
+	events := fs.String("events", "invitee.created", "comma separated event kinds: invitee.created, invitee.canceled or invitee.cancelled")
+	eventType := fs.String("event-type", "", "UUID or URI of the event type the booking is generated from (v2)")
+	payload := fs.String("payload", "", "file holding a payload to send as is instead of generated events")
+	reason := fs.String("reason", "Simulated cancellation", "reason of the generated cancellations")
+	copies := fs.Int("copies", 1, "number of times every delivery is sent")
+	reverse := fs.Bool("reverse", false, "send the deliveries in reverse order")
+	shuffle := fs.Bool("shuffle", false, "send the deliveries in random order")
+	replay := fs.Bool("replay", false, "send every delivery again with its original signature")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *target == "" {
+		return errors.New("calendly: webhooks simulate requires -url")
+	}
+
+	sim := webhook.NewSimulator(*target, *signingKey)
+	opt := &webhook.DeliveryOpts{Copies: *copies, Reverse: *reverse}
+	if *shuffle {
+		opt.Shuffle = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	var deliveries []*webhook.Delivery
+	var err error
+	if *payload != "" {
+		deliveries, err = sendPayload(ctx, sim, *payload, opt.Copies)
+	} else {
+		var generated []interface{}
+		if generated, err = simulatedEvents(ctx, e, *events, *eventType, *reason); err != nil {
+			return err
+		}
+		deliveries, err = sim.DeliverAll(ctx, generated, opt)
+	}
+
+	sent := len(deliveries)
+	if *replay && err == nil {
+		for _, d := range deliveries[:sent] {
+			var r *webhook.Delivery
+			if r, err = sim.Replay(ctx, d); r != nil {
+				deliveries = append(deliveries, r)
+			}
+			if err != nil {
+				break
+			}
+		}
+	}
+
+	results := make([]*simulatedDelivery, 0, len(deliveries))
+	t := &table{headers: []string{"#", "EVENT", "STATUS", "SIGNED", "REPLAY"}}
+	for i, d := range deliveries {
+		envelope := &webhook.Envelope{}
+		json.Unmarshal(d.Body, envelope)
+
+		r := &simulatedDelivery{Event: envelope.Event, StatusCode: d.StatusCode, Signed: d.Signature != "", Replay: i >= sent}
+		results = append(results, r)
+		t.add(i+1, r.Event, r.StatusCode, r.Signed, r.Replay)
+	}
+
+	if perr := e.printer.print(results, t); perr != nil {
+		return perr
+	}
+
+	return err
+}
+
+// simulatedEvents generates the events of a single booking, from the given event type when set.
+func simulatedEvents(ctx context.Context, e *env, kinds, eventType, reason string) ([]interface{}, error) {
+	tmpl := webhook.NewTemplate()
+	if eventType != "" {
+		if e.client == nil {
+			return nil, e.clientErr
+		}
+		if err := requireV2(e, "webhooks simulate -event-type"); err != nil {
+			return nil, err
+		}
+
+		et, _, err := e.client.EventTypes.Get(ctx, eventType)
+		if err != nil {
+			return nil, err
+		}
+		tmpl = webhook.TemplateFromEventType(et)
+	}
+
+	now := time.Now().UTC()
+	var events []interface{}
+	for _, kind := range strings.Split(kinds, ",") {
+		switch k := calendly.EventHookType(strings.TrimSpace(kind)); k {
+		case calendly.InviteeCreatedHookType:
+			events = append(events, tmpl.InviteeCreated(now))
+		case calendly.InviteeCanceledHookType, calendly.InviteeCancelledHookType:
+			cancelled := tmpl.InviteeCancelled(now, reason)
+			cancelled.Event = k
+			events = append(events, cancelled)
+		default:
+			return nil, fmt.Errorf("calendly: cannot simulate %q events", kind)
+		}
+	}
+
+	return events, nil
+}
+
+// sendPayload sends the payload of a file the given number of times.
+func sendPayload(ctx context.Context, sim *webhook.Simulator, path string, copies int) ([]*webhook.Delivery, error) {
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("calendly: cannot read payload: %v", err)
+	}
+
+	if copies < 1 {
+		copies = 1
+	}
+
+	var deliveries []*webhook.Delivery
+	for i := 0; i < copies; i++ {
+		d, err := sim.Send(ctx, body)
+		if d != nil {
+			deliveries = append(deliveries, d)
+		}
+		if err != nil {
+			return deliveries, err
+		}
+	}
+
+	return deliveries, nil
+}
+
+// currentUser returns the user the CLI is authenticated as.
+func currentUser(ctx context.Context, e *env) (*calendly.AboutMe, error) {
+	me, _, err := e.client.Users.AboutMe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if me == nil {
+		return nil, errors.New("calendly: cannot determine the current user")
+	}
+
+	return me, nil
+}
+
+// currentOrganization returns the URI of the organization of the current user.
+func currentOrganization(ctx context.Context, e *env) (string, error) {
+	me, err := currentUser(ctx, e)
+	if err != nil {
+		return "", err
+	}
+	if me.Attributes == nil || me.Attributes.CurrentOrganization == "" {
+		return "", errors.New("calendly: cannot determine the current organization, set -organization")
+	}
+
+	return me.Attributes.CurrentOrganization, nil
+}
+
+// requireV2 fails commands of API v2 only services when the CLI is authenticated with a v1 API key.
+func requireV2(e *env, name string) error {
+	if e.client.APIVersion() != calendly.APIV2 {
+		return fmt.Errorf("calendly: %v requires an API v2 token, set %v", name, tokenEnv)
+	}
+
+	return nil
+}
+
+// webhookID returns the numeric ID of a v1 webhook subscription.
+func webhookID(e *env, s string) (int64, bool) {
+	if e.client.APIVersion() == calendly.APIV2 {
+		return 0, false
+	}
+
+	id, err := strconv.ParseInt(s, 10, 64)
+	return id, err == nil
+}
+
+func webhooksTable(webhooks ...*calendly.Webhook) *table {
+	t := &table{headers: []string{"ID", "URL", "STATE", "SCOPE", "EVENTS"}}
+	for _, w := range webhooks {
+		id := w.URI
+		if id == "" {
+			id = strconv.FormatInt(w.ID, 10)
+		}
+
+		a := w.Attributes
+		if a == nil {
+			a = &calendly.WebhookAttributes{}
+		}
+
+		events := make([]string, 0, len(a.Events))
+		for _, ev := range a.Events {
+			events = append(events, string(ev))
+		}
+		t.add(id, a.URL, a.State, a.Scope, strings.Join(events, ","))
+	}
+
+	return t
+}
+
+// parseWindow returns the window of the availability commands, a week starting at the next
+// minute by default. The default start is rounded up rather than now, so that it is still in
+// the future when the request arrives and repeated runs within a minute query the same window.
+func parseWindow(start, end string) (calendly.TimeRange, error) {
+	s, err := parseTime(start)
+	if err != nil {
+		return calendly.TimeRange{}, err
+	}
+	if s.IsZero() {
+		s = time.Now().UTC().Truncate(time.Minute).Add(time.Minute)
+	}
+
+	e, err := parseTime(end)
+	if err != nil {
+		return calendly.TimeRange{}, err
+	}
+	if e.IsZero() {
+		e = s.AddDate(0, 0, 7)
+	}
+
+	return calendly.TimeRange{Start: s, End: e}, nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("calendly: invalid time %q, expected RFC 3339", s)
+	}
+
+	return t, nil
+}
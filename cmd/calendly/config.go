@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/theodesp/go-calendly/calendly"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	tokenEnv   = "CALENDLY_TOKEN"
+	apiKeyEnv  = "CALENDLY_API_KEY"
+	baseURLEnv = "CALENDLY_BASE_URL"
+)
+
+// config holds the credentials of the CLI. The environment takes precedence over the config file.
+type config struct {
+	// API v2 personal access token
+	Token string `yaml:"token"`
+
+	// v1 API key, used when no token is set
+	APIKey string `yaml:"api_key"`
+
+	// Base URL of the API, the default of the API version when empty
+	BaseURL string `yaml:"base_url"`
+}
+
+// loadConfig reads the config file, the default one when path is empty, and applies the environment.
+// A missing default config file is not an error.
+func loadConfig(path string, getenv func(string) string) (*config, error) {
+	c := &config{}
+
+	explicit := path != ""
+	if !explicit {
+		if dir, err := os.UserConfigDir(); err == nil {
+			path = filepath.Join(dir, "calendly", "config.yaml")
+		}
+	}
+
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := yaml.Unmarshal(data, c); err != nil {
+				return nil, fmt.Errorf("calendly: cannot parse config file %v: %v", path, err)
+			}
+		case explicit || !os.IsNotExist(err):
+			return nil, fmt.Errorf("calendly: cannot read config file: %v", err)
+		}
+	}
+
+	if v := getenv(tokenEnv); v != "" {
+		c.Token = v
+	}
+	if v := getenv(apiKeyEnv); v != "" {
+		c.APIKey = v
+	}
+	if v := getenv(baseURLEnv); v != "" {
+		c.BaseURL = v
+	}
+
+	return c, nil
+}
+
+// client returns a client authenticated with the token, or the API key when there is no token.
+func (c *config) client() (*calendly.Client, error) {
+	var client *calendly.Client
+	switch {
+	case c.Token != "":
+		client = calendly.NewClient(calendly.NewBearerAuthClient(c.Token), calendly.WithAPIVersion(calendly.APIV2))
+	case c.APIKey != "":
+		client = calendly.NewClient(calendly.NewTokenAuthClient(&calendly.Config{ApiKey: c.APIKey}))
+	default:
+		return nil, errors.New("calendly: no credentials, set " + tokenEnv + " or " + apiKeyEnv)
+	}
+
+	if c.BaseURL != "" {
+		if err := client.SetBaseURL(c.BaseURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
@@ -0,0 +1,105 @@
+/*
+Command calendly inspects Calendly accounts from the command line.
+
+Usage:
+
+	calendly [-o table|json|yaml] [-config file] <command> [<subcommand>] [flags]
+
+Commands:
+
+	whoami                                print the current user
+	event-types list                      list event types
+	events list                           list scheduled events
+	invitees list <event>                 list the invitees of a scheduled event
+	organizations memberships             list the members of an organization
+	organizations invitations             list the invitations to join an organization
+	availability times <event-type>       list the open slots of an event type
+	availability busy-times               list the times a user is busy
+	availability schedules                list the availability schedules of a user
+	scheduling-links create <event-type>  create a single-use scheduling link
+	webhooks list                         list webhook subscriptions
+	webhooks get <id>                     print a webhook subscription
+	webhooks create -url <url>            create a webhook subscription
+	webhooks delete <id>                  delete a webhook subscription
+	webhooks simulate -url <url>          send signed test deliveries to a webhook receiver
+
+The API v2 personal access token is read from the CALENDLY_TOKEN environment variable and
+the v1 API key from CALENDLY_API_KEY. Either may also be set in the config file,
+$HOME/.config/calendly/config.yaml by default:
+
+	token: <personal access token>
+	api_key: <v1 API key>
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	// Embed the timezone database so that timezones resolve on systems without one
+	_ "time/tzdata"
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Getenv, os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code of the process.
+func run(ctx context.Context, args []string, getenv func(string) string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("calendly", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("o", formatTable, "output format: table, json or yaml")
+	configPath := fs.String("config", "", "path of the config file")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: calendly [-o table|json|yaml] [-config file] <command> [<subcommand>] [flags]")
+		fmt.Fprintln(stderr, "commands: whoami, event-types list, events list, invitees list, organizations memberships|invitations, "+
+			"availability times|busy-times|schedules, scheduling-links create, webhooks list|get|create|delete|simulate")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	p, err := newPrinter(stdout, *format)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	config, err := loadConfig(*configPath, getenv)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	name, cmd, cmdArgs, err := lookup(fs.Args())
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		fs.Usage()
+		return 2
+	}
+
+	e := &env{printer: p, stderr: stderr}
+	e.client, e.clientErr = config.client()
+	if e.clientErr != nil && !offline[name] {
+		fmt.Fprintln(stderr, e.clientErr)
+		return 1
+	}
+
+	if err := cmd(ctx, e, cmdArgs); err != nil {
+		if err == flag.ErrHelp {
+			return 2
+		}
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	return 0
+}
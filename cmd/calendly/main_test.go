@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/theodesp/go-calendly/calendly/webhook"
+)
+
+// runCLI runs the CLI against a test server, authenticated with an API v2 token.
+func runCLI(t *testing.T, mux *http.ServeMux, args ...string) (int, string, string) {
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	env := map[string]string{tokenEnv: "pat", baseURLEnv: server.URL + "/"}
+	getenv := func(k string) string { return env[k] }
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), append([]string{"-config", writeConfig(t, "")}, args...), getenv, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func handleAboutMe(mux *http.ServeMux) {
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/users/U1","name":"Jane","email":"jane@example.com",`+
+			`"timezone":"Europe/Athens","current_organization":"https://api.calendly.com/organizations/O1"}}`)
+	})
+}
+
+func TestWhoami(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+	handleAboutMe(mux)
+
+	code, stdout, stderr := runCLI(t, mux, "whoami")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, "ID")
+	assert.Contains(stdout, "jane@example.com")
+	assert.Contains(stdout, "Europe/Athens")
+
+	code, stdout, _ = runCLI(t, mux, "-o", "json", "whoami")
+	assert.Equal(0, code)
+	assert.Contains(stdout, `"email": "jane@example.com"`)
+
+	code, stdout, _ = runCLI(t, mux, "-o", "yaml", "whoami")
+	assert.Equal(0, code)
+	assert.Contains(stdout, "email: jane@example.com")
+}
+
+func TestWhoamiNoUser(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resource":null}`)
+	})
+
+	code, _, stderr := runCLI(t, mux, "whoami")
+	assert.Equal(1, code)
+	assert.Contains(stderr, "does not contain a user")
+}
+
+func TestEventsList(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+	handleAboutMe(mux)
+	mux.HandleFunc("/scheduled_events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("https://api.calendly.com/users/U1", r.URL.Query().Get("user"))
+		assert.Equal("2020-01-01T00:00:00Z", r.URL.Query().Get("min_start_time"))
+		if r.URL.Query().Get("page_token") == "" {
+			fmt.Fprint(w, `{"collection":[{"uri":"E1","name":"Intro","status":"active"}],"pagination":{"next_page_token":"P2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"collection":[{"uri":"E2","name":"Demo","status":"canceled"}],"pagination":{}}`)
+	})
+
+	code, stdout, stderr := runCLI(t, mux, "events", "list", "-min-start", "2020-01-01T00:00:00Z")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, "E1")
+	assert.Contains(stdout, "E2")
+
+	code, _, stderr = runCLI(t, mux, "events", "list", "-min-start", "yesterday")
+	assert.Equal(1, code)
+	assert.Contains(stderr, "invalid time")
+}
+
+func TestOrganizationsMemberships(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+	handleAboutMe(mux)
+	mux.HandleFunc("/organization_memberships", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("https://api.calendly.com/organizations/O1", r.URL.Query().Get("organization"))
+		assert.Equal("admin", r.URL.Query().Get("role"))
+		fmt.Fprint(w, `{"collection":[{"uri":"M1","role":"admin","user":{"uri":"U2","name":"Joe","email":"joe@example.com"},`+
+			`"organization":"https://api.calendly.com/organizations/O1"}],"pagination":{}}`)
+	})
+
+	code, stdout, stderr := runCLI(t, mux, "organizations", "memberships", "-role", "admin")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, "joe@example.com")
+
+	code, stdout, stderr = runCLI(t, mux, "-o", "json", "organizations", "memberships", "-role", "admin")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, `"organization": "https://api.calendly.com/organizations/O1"`)
+}
+
+func TestAvailabilityBusyTimes(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+	handleAboutMe(mux)
+	mux.HandleFunc("/user_busy_times", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal("https://api.calendly.com/users/U1", q.Get("user"))
+		assert.Equal("2020-01-01T00:00:00Z", q.Get("start_time"))
+		assert.Equal("2020-01-08T00:00:00Z", q.Get("end_time"))
+		fmt.Fprint(w, `{"collection":[{"type":"calendly","start_time":"2020-01-02T10:00:00Z","end_time":"2020-01-02T11:00:00Z",`+
+			`"event":{"uri":"E1"}}]}`)
+	})
+
+	code, stdout, stderr := runCLI(t, mux, "availability", "busy-times", "-start", "2020-01-01T00:00:00Z")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, "2020-01-02T10:00:00Z")
+	assert.Contains(stdout, "E1")
+}
+
+func TestParseWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	w, err := parseWindow("", "")
+	assert.Nil(err)
+	assert.True(w.Start.After(now))
+	assert.Equal(w.Start, w.Start.Truncate(time.Minute))
+	assert.Equal(w.Start.AddDate(0, 0, 7), w.End)
+
+	w, err = parseWindow("2020-01-01T00:00:00Z", "2020-01-02T00:00:00Z")
+	assert.Nil(err)
+	assert.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), w.Start)
+	assert.Equal(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), w.End)
+}
+
+func TestSchedulingLinksCreate(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/scheduling_links", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(string(body), `"max_event_count":2`)
+		assert.Contains(string(body), `"owner":"https://api.calendly.com/event_types/ET1"`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resource":{"booking_url":"https://calendly.com/d/abc","owner":"https://api.calendly.com/event_types/ET1",`+
+			`"owner_type":"EventType"}}`)
+	})
+
+	code, stdout, stderr := runCLI(t, mux, "scheduling-links", "create", "-max-events", "2",
+		"https://api.calendly.com/event_types/ET1")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, "https://calendly.com/d/abc")
+
+	code, _, stderr = runCLI(t, mux, "scheduling-links", "create")
+	assert.Equal(1, code)
+	assert.Contains(stderr, "requires the URI of an event type")
+}
+
+func TestWebhooksCreateAndDelete(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+	handleAboutMe(mux)
+	mux.HandleFunc("/webhook_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(string(body), `"events":["invitee.created","invitee.canceled"]`)
+		assert.Contains(string(body), `"scope":"organization"`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"resource":{"uri":"https://api.calendly.com/webhook_subscriptions/W1",`+
+			`"callback_url":"https://example.com/hook","state":"active"}}`)
+	})
+	mux.HandleFunc("/webhook_subscriptions/W1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	code, stdout, stderr := runCLI(t, mux, "webhooks", "create", "-url", "https://example.com/hook",
+		"-events", "invitee.created,invitee.canceled", "-scope", "organization")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, "https://api.calendly.com/webhook_subscriptions/W1")
+
+	code, _, stderr = runCLI(t, mux, "webhooks", "delete", "https://api.calendly.com/webhook_subscriptions/W1")
+	assert.Equal(0, code, stderr)
+}
+
+func TestWebhooksSimulate(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	v := webhook.NewVerifier("key")
+	h := webhook.NewHandler()
+	h.OnInviteeCreated(func(ctx context.Context, e *webhook.InviteeCreatedEvent) error {
+		events = append(events, string(e.Event))
+		return nil
+	})
+	h.OnInviteeCancelled(func(ctx context.Context, e *webhook.InviteeCancelledEvent) error {
+		events = append(events, string(e.Event))
+		return nil
+	})
+	receiver := httptest.NewServer(v.Middleware(h))
+	defer receiver.Close()
+
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"-config", writeConfig(t, ""), "-o", "json",
+		"webhooks", "simulate", "-url", receiver.URL, "-signing-key", "key",
+		"-events", "invitee.created,invitee.canceled", "-reverse", "-replay"},
+		func(string) string { return "" }, &stdout, &stderr)
+
+	assert.Equal(0, code, stderr.String())
+	assert.Equal([]string{"invitee.canceled", "invitee.created", "invitee.canceled", "invitee.created"}, events)
+	assert.Contains(stdout.String(), `"replay": true`)
+	assert.Contains(stdout.String(), `"status_code": 200`)
+}
+
+func TestWebhooksSimulate_EventTypeRequiresCredentials(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"-config", writeConfig(t, ""),
+		"webhooks", "simulate", "-url", "http://localhost:1", "-event-type", "ET1"},
+		func(string) string { return "" }, &stdout, &stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "no credentials")
+}
+
+func TestUsage(t *testing.T) {
+	assert := assert.New(t)
+	mux := http.NewServeMux()
+
+	code, _, stderr := runCLI(t, mux, "meetings")
+	assert.Equal(2, code)
+	assert.Contains(stderr, `unknown command "meetings"`)
+
+	code, _, stderr = runCLI(t, mux, "webhooks")
+	assert.Equal(2, code)
+	assert.Contains(stderr, "scheduling-links create")
+
+	code, _, stderr = runCLI(t, mux, "-o", "xml", "whoami")
+	assert.Equal(2, code)
+	assert.Contains(stderr, "unknown output format")
+}
+
+func TestLoadConfig(t *testing.T) {
+	assert := assert.New(t)
+	path := writeConfig(t, "token: from-file\napi_key: key\n")
+
+	c, err := loadConfig(path, func(string) string { return "" })
+	assert.Nil(err)
+	assert.Equal("from-file", c.Token)
+	assert.Equal("key", c.APIKey)
+
+	c, err = loadConfig(path, func(k string) string {
+		if k == tokenEnv {
+			return "from-env"
+		}
+		return ""
+	})
+	assert.Nil(err)
+	assert.Equal("from-env", c.Token)
+
+	_, err = loadConfig(filepath.Join(t.TempDir(), "missing.yaml"), func(string) string { return "" })
+	assert.NotNil(err)
+
+	_, err = (&config{}).client()
+	assert.NotNil(err)
+}